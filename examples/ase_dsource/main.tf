@@ -0,0 +1,44 @@
+/**
+* Summary: This template showcases the properties available when creating a SAP ASE dsource.
+*/
+
+terraform {
+  required_providers {
+    delphix = {
+      version = "VERSION"
+      source  = "delphix-integrations/delphix"
+    }
+  }
+}
+
+provider "delphix" {
+  tls_insecure_skip = true
+  key               = "1.XXXX"
+  host              = "HOSTNAME"
+}
+
+
+
+resource "delphix_ase_dsource" "test_ase_dsource" {
+  name                       = "test2"
+  source_value               = "1-ASE_DB_CONFIG-1"
+  group_id                   = "4-GROUP-1"
+  log_sync_enabled           = false
+  make_current_account_owner = true
+  staging_repository         = "1-ASE_INSTANCE-2"
+  staging_host_user          = "1-HOST_USER-2"
+  sync_strategy              = "new_backup"
+  load_backup_path           = "/backups/ase"
+  backup_server_name         = "ASE_BS"
+  db_user                    = "sa"
+  db_password                = "password"
+  ops_pre_sync {
+    name    = "key-1"
+    command = "echo \"hello world\""
+    shell   = "shell"
+  }
+  tags {
+    key   = "key-1"
+    value = "value-1"
+  }
+}
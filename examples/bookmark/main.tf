@@ -0,0 +1,46 @@
+/**
+* Summary: This template showcases how to
+* 1) Provision a VDB from a snapshot
+* 2) Pin the latest point of the VDB with a bookmark
+* 3) Provision a second VDB from the bookmark
+*/
+
+terraform {
+  required_providers {
+    delphix = {
+      version = ">=3.3.2"
+      source  = "delphix-integrations/delphix"
+    }
+  }
+}
+
+// *** Requirement***: Update the key and host with valid credentials.
+provider "delphix" {
+  tls_insecure_skip = true
+  key               = "1.XXXX"
+  host              = "HOSTNAME"
+}
+
+// *** Requirement***: Update the Snapshot ID with a valid Snapshot.
+resource "delphix_vdb" "qa" {
+  name                   = "qa"
+  source_data_id         = "6-ORACLE_DB_CONTAINER-7"
+  auto_select_repository = true
+}
+
+resource "delphix_bookmark" "golden" {
+  name       = "golden-dataset"
+  vdb_ids    = [delphix_vdb.qa.id]
+  expiration = "2027-01-31"
+  tags {
+    key   = "team"
+    value = "qa"
+  }
+}
+
+resource "delphix_vdb" "from_bookmark" {
+  name                   = "qa-copy"
+  provision_type         = "bookmark"
+  bookmark_id            = delphix_bookmark.golden.id
+  auto_select_repository = true
+}
@@ -0,0 +1,45 @@
+/**
+* Summary: This template showcases the properties available when creating a Microsoft SQL Server dsource.
+*/
+
+terraform {
+  required_providers {
+    delphix = {
+      version = "VERSION"
+      source  = "delphix-integrations/delphix"
+    }
+  }
+}
+
+provider "delphix" {
+  tls_insecure_skip = true
+  key               = "1.XXXX"
+  host              = "HOSTNAME"
+}
+
+
+
+resource "delphix_mssql_dsource" "test_mssql_dsource" {
+  name                       = "test2"
+  source_value               = "SQLSOURCE-1"
+  group_id                   = "4-GROUP-1"
+  log_sync_enabled           = false
+  make_current_account_owner = true
+  sync_strategy              = "external_backup"
+  staging_environment        = "3-WINDOWS_HOST_ENVIRONMENT-2"
+  ppt_repository             = "3-MSSQL_INSTANCE-2"
+  ppt_host_user              = "3-HOST_USER-2"
+  validated_sync_mode        = "TRANSACTION_LOG"
+  shared_backup_locations {
+    backup_location = "\\\\backupserver\\share\\sqlsource"
+  }
+  ops_pre_sync {
+    name    = "key-1"
+    command = "echo \"hello world\""
+    shell   = "ps"
+  }
+  tags {
+    key   = "key-1"
+    value = "value-1"
+  }
+}
@@ -0,0 +1,42 @@
+/**
+* Summary: This template showcases the properties available when creating an Oracle staging push dsource.
+*/
+
+terraform {
+  required_providers {
+    delphix = {
+      version = "VERSION"
+      source  = "delphix-integrations/delphix"
+    }
+  }
+}
+
+provider "delphix" {
+  tls_insecure_skip = true
+  key               = "1.XXXX"
+  host              = "HOSTNAME"
+}
+
+
+
+resource "delphix_oracle_staging_push_dsource" "test_oracle_staging_push_dsource" {
+  name                     = "stgpush"
+  group_id                 = "4-GROUP-1"
+  staging_environment      = "1-UNIX_HOST_ENVIRONMENT-2"
+  staging_environment_user = "1-HOST_USER-2"
+  repository               = "1-ORACLE_INSTALL-3"
+  database_name            = "stgpush"
+  database_unique_name     = "stgpush"
+  sid                      = "stgpush"
+  container_type           = "NON_CDB"
+  mount_base               = "/mnt/provision"
+  ops_post_sync {
+    name    = "restore"
+    command = "/home/delphix/scripts/rman_restore.sh"
+    shell   = "bash"
+  }
+  tags {
+    key   = "key-1"
+    value = "value-1"
+  }
+}
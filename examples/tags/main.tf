@@ -0,0 +1,46 @@
+/**
+* Summary: This template showcases how to
+* 1) Tag every object created by the provider with default tags
+* 2) Tag a VDB group managed by Terraform
+* 3) Tag a VDB that is not managed by Terraform
+*/
+
+terraform {
+  required_providers {
+    delphix = {
+      version = ">=3.3.2"
+      source  = "delphix-integrations/delphix"
+    }
+  }
+}
+
+// *** Requirement***: Update the key and host with valid credentials.
+provider "delphix" {
+  tls_insecure_skip = true
+  key               = "1.XXXX"
+  host              = "HOSTNAME"
+
+  default_tags {
+    key   = "managed-by"
+    value = "terraform"
+  }
+}
+
+// *** Requirement***: Update the VDB IDs with valid VDBs.
+resource "delphix_vdb_group" "qa" {
+  name    = "qa"
+  vdb_ids = ["1-ORACLE_DB_CONTAINER-3"]
+  tags {
+    key   = "team"
+    value = "qa"
+  }
+}
+
+resource "delphix_tags" "reporting_vdb" {
+  object_type = "vdb"
+  object_id   = "1-ORACLE_DB_CONTAINER-5"
+  tags {
+    key   = "cost-center"
+    value = "reporting"
+  }
+}
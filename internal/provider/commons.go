@@ -1,21 +1,34 @@
 package provider
 
 const (
-	Pending                string = "PENDING"
-	Started                string = "STARTED"
-	Timedout               string = "TIMEDOUT"
-	Failed                 string = "FAILED"
-	Completed              string = "COMPLETED"
-	Canceled               string = "CANCELED"
-	Abandoned              string = "ABANDONED"
-	JOB_STATUS_SLEEP_TIME  int    = 5
-	STATUS_POLL_SLEEP_TIME int    = 20
-	DLPX                   string = "[DELPHIX] "
-	INFO                   string = "[INFO] "
-	WARN                   string = "[WARN] "
-	ERROR                  string = "[ERROR] "
+	Pending                  string = "PENDING"
+	Started                  string = "STARTED"
+	Timedout                 string = "TIMEDOUT"
+	Failed                   string = "FAILED"
+	Completed                string = "COMPLETED"
+	Canceled                 string = "CANCELED"
+	Abandoned                string = "ABANDONED"
+	JOB_STATUS_SLEEP_TIME    int    = 5
+	STATUS_POLL_SLEEP_TIME   int    = 20
+	DLPX                     string = "[DELPHIX] "
+	INFO                     string = "[INFO] "
+	WARN                     string = "[WARN] "
+	ERROR                    string = "[ERROR] "
+	SnapshotWaitFail         string = "fail"
+	SnapshotWaitWarn         string = "warn"
+	LinkPhaseLink            string = "link"
+	LinkPhaseSnapshot        string = "snapshot"
+	LinkPhaseLogSync         string = "log_sync"
+	LinkPhaseUnknown         string = "unknown"
+	EngineTypeVirtualization string = "VIRTUALIZATION"
+	EngineTypeMasking        string = "MASKING"
 )
 
+// defaultRollbackPhases are the link phases rolled back when rollback_on_failure_phases is not set.
+// A failed link leaves an unusable dSource, while after a snapshot or log sync failure the linked
+// dSource is kept so that the sync can be retried.
+var defaultRollbackPhases = []string{LinkPhaseLink}
+
 var updatableVdbKeys = map[string]bool{
 	"name":                          true,
 	"db_username":                   true,
@@ -92,21 +105,217 @@ var isDestructiveVdbUpdate = map[string]bool{
 }
 
 var updatableOracleDsourceKeys = map[string]bool{
-	"name":                       true,
-	"environment_user_id":        true,
-	"backup_level_enabled":       true,
-	"rman_channels":              true,
-	"files_per_set":              true,
-	"check_logical":              true,
-	"encrypted_linking_enabled":  true,
-	"compressed_linking_enabled": true,
-	"bandwidth_limit":            true,
-	"number_of_connections":      true,
-	"pre_provisioning_enabled":   true,
-	"diagnose_no_logging_faults": true,
-	"external_file_path":         true,
-	"tags":                       true,
-	"ops_pre_sync":               true,
-	"ops_pre_log_sync":           true,
-	"ops_post_sync":              true,
+	"name":                            true,
+	"environment_user_id":             true,
+	"backup_level_enabled":            true,
+	"rman_channels":                   true,
+	"files_per_set":                   true,
+	"check_logical":                   true,
+	"encrypted_linking_enabled":       true,
+	"compressed_linking_enabled":      true,
+	"bandwidth_limit":                 true,
+	"number_of_connections":           true,
+	"pre_provisioning_enabled":        true,
+	"diagnose_no_logging_faults":      true,
+	"external_file_path":              true,
+	"tags":                            true,
+	"ops_pre_sync":                    true,
+	"ops_pre_log_sync":                true,
+	"ops_post_sync":                   true,
+	"sync_trigger":                    true,
+	"sync_trigger_options":            true,
+	"last_sync_snapshot_id":           true,
+	"last_sync_snapshot_timestamp":    true,
+	"last_sync_snapshot_timeflow_id":  true,
+	"enabled":                         true,
+	"is_detached":                     true,
+	"attach_source_id":                true,
+	"attach_username":                 true,
+	"attach_password":                 true,
+	"attach_environment_user_id":      true,
+	"upgrade_repository_id":           true,
+	"upgrade_environment_user_id":     true,
+	"delete_dependents":               true,
+	"deletion_protection":             true,
+	"wait_time":                       true,
+	"skip_wait_for_snapshot_creation": true,
+	"snapshot_wait_failure_action":    true,
+	"link_now":                        true,
+	"force_full_backup":               true,
+	"double_sync":                     true,
+	"skip_space_check":                true,
+	"do_not_resume":                   true,
+	"files_for_full_backup":           true,
+}
+
+var updatableEnvironmentRepositoryKeys = map[string]bool{
+	"name":               true,
+	"version":            true,
+	"oracle_base":        true,
+	"bits":               true,
+	"allow_provisioning": true,
+	"is_staging":         true,
+}
+
+var updatableAppdataDsourceKeys = map[string]bool{
+	"name":                            true,
+	"description":                     true,
+	"staging_environment":             true,
+	"staging_environment_user":        true,
+	"parameters":                      true,
+	"sync_parameters":                 true,
+	"tags":                            true,
+	"ops_pre_sync":                    true,
+	"ops_post_sync":                   true,
+	"sync_trigger":                    true,
+	"sync_trigger_parameters":         true,
+	"last_sync_snapshot_id":           true,
+	"last_sync_snapshot_timestamp":    true,
+	"last_sync_snapshot_timeflow_id":  true,
+	"enabled":                         true,
+	"is_detached":                     true,
+	"attach_source_id":                true,
+	"upgrade_repository_id":           true,
+	"upgrade_environment_user_id":     true,
+	"delete_dependents":               true,
+	"deletion_protection":             true,
+	"wait_time":                       true,
+	"skip_wait_for_snapshot_creation": true,
+	"snapshot_wait_failure_action":    true,
+}
+
+var updatableEngineRegistrationKeys = map[string]bool{
+	"name":                         true,
+	"username":                     true,
+	"password":                     true,
+	"hashicorp_vault_id":           true,
+	"hashicorp_vault_engine":       true,
+	"hashicorp_vault_secret_path":  true,
+	"hashicorp_vault_username_key": true,
+	"hashicorp_vault_secret_key":   true,
+	"insecure_ssl":                 true,
+	"unsafe_ssl_hostname_check":    true,
+	"truststore_filename":          true,
+	"truststore_password":          true,
+	"tags":                         true,
+}
+
+var updatableBookmarkKeys = map[string]bool{
+	"name":           true,
+	"expiration":     true,
+	"retain_forever": true,
+	"tags":           true,
+}
+
+// dsourceLocalKeys are dSource attributes that are handled by the provider after the
+// dSource update API call, such as tags, on-demand syncs, upgrades and enable or attach changes.
+// The snapshot wait settings and create-only link inputs only apply to the link and are just saved
+// to the state. The last sync snapshot attributes are computed and change with every sync_trigger change.
+var dsourceLocalKeys = map[string]bool{
+	"tags":                            true,
+	"sync_trigger":                    true,
+	"sync_trigger_options":            true,
+	"sync_trigger_parameters":         true,
+	"last_sync_snapshot_id":           true,
+	"last_sync_snapshot_timestamp":    true,
+	"last_sync_snapshot_timeflow_id":  true,
+	"enabled":                         true,
+	"is_detached":                     true,
+	"attach_source_id":                true,
+	"attach_username":                 true,
+	"attach_password":                 true,
+	"attach_environment_user_id":      true,
+	"upgrade_repository_id":           true,
+	"upgrade_environment_user_id":     true,
+	"delete_dependents":               true,
+	"deletion_protection":             true,
+	"wait_time":                       true,
+	"skip_wait_for_snapshot_creation": true,
+	"snapshot_wait_failure_action":    true,
+	"link_now":                        true,
+	"force_full_backup":               true,
+	"double_sync":                     true,
+	"skip_space_check":                true,
+	"do_not_resume":                   true,
+	"files_for_full_backup":           true,
+	"make_current_account_owner":      true,
+}
+
+var updatableMssqlDsourceKeys = map[string]bool{
+	"name":                    true,
+	"description":             true,
+	"ppt_host_user":           true,
+	"source_host_user":        true,
+	"staging_pre_script":      true,
+	"staging_post_script":     true,
+	"encryption_key":          true,
+	"external_file_path":      true,
+	"shared_backup_locations": true,
+	"validated_sync_mode":     true,
+	"delphix_managed_backup_compression_enabled": true,
+	"delphix_managed_backup_policy":              true,
+	"tags":                                       true,
+	"ops_pre_sync":                               true,
+	"ops_post_sync":                              true,
+	"delete_dependents":                          true,
+	"deletion_protection":                        true,
+	"wait_time":                                  true,
+	"skip_wait_for_snapshot_creation":            true,
+	"snapshot_wait_failure_action":               true,
+	"make_current_account_owner":                 true,
+}
+
+var updatableAseDsourceKeys = map[string]bool{
+	"name":                            true,
+	"description":                     true,
+	"staging_host_user":               true,
+	"source_host_user":                true,
+	"external_file_path":              true,
+	"load_backup_path":                true,
+	"backup_server_name":              true,
+	"backup_host":                     true,
+	"backup_host_user":                true,
+	"dump_history_file_enabled":       true,
+	"drop_and_recreate_devices":       true,
+	"validated_sync_mode":             true,
+	"dump_credentials":                true,
+	"db_user":                         true,
+	"db_password":                     true,
+	"db_hashicorp_vault_engine":       true,
+	"db_hashicorp_vault_secret_path":  true,
+	"db_hashicorp_vault_username_key": true,
+	"db_hashicorp_vault_secret_key":   true,
+	"db_azure_vault_name":             true,
+	"db_azure_vault_username_key":     true,
+	"db_azure_vault_secret_key":       true,
+	"db_cyberark_vault_query_string":  true,
+	"tags":                            true,
+	"ops_pre_sync":                    true,
+	"ops_post_sync":                   true,
+	"pre_validated_sync":              true,
+	"post_validated_sync":             true,
+	"delete_dependents":               true,
+	"deletion_protection":             true,
+	"wait_time":                       true,
+	"skip_wait_for_snapshot_creation": true,
+	"snapshot_wait_failure_action":    true,
+	"make_current_account_owner":      true,
+}
+
+var updatableOracleStagingPushDsourceKeys = map[string]bool{
+	"name":                     true,
+	"staging_environment_user": true,
+	"allow_auto_staging_restart_on_host_reboot": true,
+	"physical_standby":                          true,
+	"validate_by_opening_db_in_read_only_mode":  true,
+	"tags":                            true,
+	"ops_pre_sync":                    true,
+	"ops_post_sync":                   true,
+	"ops_pre_log_sync":                true,
+	"delete_dependents":               true,
+	"deletion_protection":             true,
+	"wait_time":                       true,
+	"skip_wait_for_snapshot_creation": true,
+	"snapshot_wait_failure_action":    true,
+	"make_current_account_owner":      true,
 }
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	lineageTypeVdb     = "VDB"
+	lineageTypeDsource = "DSource"
+)
+
+func dataSourceDatasetLineage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the ancestors and descendants of a dSource or VDB.",
+
+		ReadContext: dataSourceDatasetLineageRead,
+
+		Schema: map[string]*schema.Schema{
+			"dataset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_replicas": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// Output
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ancestor_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ancestors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lineageNodeSchema(),
+				},
+			},
+			"descendant_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"descendants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lineageNodeSchema(),
+				},
+			},
+		},
+	}
+}
+
+// lineageNodeSchema returns the attributes exported for each dataset of the lineage.
+func lineageNodeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"parent_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"depth": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"is_replica": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+// lineageDataset is a dSource or VDB of the lineage, with the references to its parent and, for
+// replicas, to the object it is replicated from.
+type lineageDataset struct {
+	id              string
+	name            string
+	datasetType     string
+	engineId        string
+	engineName      string
+	status          string
+	parentId        string
+	primaryObjectId string
+	isReplica       bool
+}
+
+func vdbLineageDataset(vdb dctapi.VDB) lineageDataset {
+	return lineageDataset{
+		id:              vdb.GetId(),
+		name:            vdb.GetName(),
+		datasetType:     lineageTypeVdb,
+		engineId:        vdb.GetEngineId(),
+		engineName:      vdb.GetEngineName(),
+		status:          vdb.GetStatus(),
+		parentId:        vdb.GetParentId(),
+		primaryObjectId: vdb.GetPrimaryObjectId(),
+		isReplica:       vdb.GetIsReplica(),
+	}
+}
+
+func dsourceLineageDataset(dsource dctapi.DSource) lineageDataset {
+	return lineageDataset{
+		id:              dsource.GetId(),
+		name:            dsource.GetName(),
+		datasetType:     lineageTypeDsource,
+		engineId:        dsource.GetEngineId(),
+		engineName:      dsource.GetEngineName(),
+		status:          dsource.GetStatus(),
+		primaryObjectId: dsource.GetPrimaryObjectId(),
+		isReplica:       dsource.GetIsReplica(),
+	}
+}
+
+func flattenLineageDataset(dataset lineageDataset, parentId string, depth int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          dataset.id,
+		"name":        dataset.name,
+		"type":        dataset.datasetType,
+		"engine_id":   dataset.engineId,
+		"engine_name": dataset.engineName,
+		"status":      dataset.status,
+		"parent_id":   parentId,
+		"depth":       depth,
+		"is_replica":  dataset.isReplica,
+	}
+}
+
+// lineageParentId returns the ID of the dataset the lineage dataset was provisioned or replicated
+// from, or an empty string for a root dSource.
+func lineageParentId(dataset lineageDataset, includeReplicas bool) string {
+	if dataset.parentId != "" {
+		return dataset.parentId
+	}
+	if includeReplicas && dataset.isReplica {
+		return dataset.primaryObjectId
+	}
+	return ""
+}
+
+// getLineageDataset returns the VDB or dSource with the given ID, or nil if there is none.
+func getLineageDataset(ctx context.Context, client *dctapi.APIClient, id string) (*lineageDataset, diag.Diagnostics) {
+	filter := "id eq " + filterLiteral(id)
+	vdbs, diags := searchVdbs(ctx, client, filter)
+	if diags != nil {
+		return nil, diags
+	}
+	if len(vdbs) > 0 {
+		dataset := vdbLineageDataset(vdbs[0])
+		return &dataset, nil
+	}
+	dsources, diags := searchDsources(ctx, client, filter)
+	if diags != nil {
+		return nil, diags
+	}
+	if len(dsources) > 0 {
+		dataset := dsourceLineageDataset(dsources[0])
+		return &dataset, nil
+	}
+	return nil, nil
+}
+
+// getLineageChildren returns the VDBs provisioned from the dataset and, when replicas are included,
+// the replicas of the dataset on other engines.
+func getLineageChildren(ctx context.Context, client *dctapi.APIClient, id string, includeReplicas bool) ([]lineageDataset, diag.Diagnostics) {
+	children := []lineageDataset{}
+	vdbs, diags := searchVdbs(ctx, client, "parent_id eq "+filterLiteral(id))
+	if diags != nil {
+		return nil, diags
+	}
+	for _, vdb := range vdbs {
+		children = append(children, vdbLineageDataset(vdb))
+	}
+	if !includeReplicas {
+		return children, nil
+	}
+
+	filter := "primary_object_id eq " + filterLiteral(id)
+	replicaVdbs, diags := searchVdbs(ctx, client, filter)
+	if diags != nil {
+		return nil, diags
+	}
+	for _, vdb := range replicaVdbs {
+		children = append(children, vdbLineageDataset(vdb))
+	}
+	replicaDsources, diags := searchDsources(ctx, client, filter)
+	if diags != nil {
+		return nil, diags
+	}
+	for _, dsource := range replicaDsources {
+		children = append(children, dsourceLineageDataset(dsource))
+	}
+	return children, nil
+}
+
+func dataSourceDatasetLineageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	datasetId := d.Get("dataset_id").(string)
+	includeReplicas := d.Get("include_replicas").(bool)
+
+	dataset, diags := getLineageDataset(ctx, client, datasetId)
+	if diags != nil {
+		return diags
+	}
+	if dataset == nil {
+		return diag.Errorf("no dSource or VDB with ID %s was found.", datasetId)
+	}
+	visited := map[string]bool{dataset.id: true}
+
+	// Ancestors are ordered from the direct parent to the root dSource.
+	ancestorIds := []string{}
+	ancestors := []interface{}{}
+	rootId := dataset.id
+	current := *dataset
+	for depth := 1; ; depth++ {
+		parentId := lineageParentId(current, includeReplicas)
+		if parentId == "" || visited[parentId] {
+			break
+		}
+		parent, diags := getLineageDataset(ctx, client, parentId)
+		if diags != nil {
+			return diags
+		}
+		if parent == nil {
+			tflog.Warn(ctx, DLPX+WARN+"Parent dataset "+parentId+" of "+current.id+" was not found, the ancestor chain is incomplete.")
+			break
+		}
+		visited[parent.id] = true
+		ancestorIds = append(ancestorIds, parent.id)
+		ancestors = append(ancestors, flattenLineageDataset(*parent, lineageParentId(*parent, includeReplicas), depth))
+		rootId = parent.id
+		current = *parent
+	}
+
+	// Descendants are walked breadth first, so that parents are listed before their children.
+	descendantIds := []string{}
+	descendants := []interface{}{}
+	type lineageLevel struct {
+		id    string
+		depth int
+	}
+	queue := []lineageLevel{{id: dataset.id, depth: 0}}
+	for len(queue) > 0 {
+		level := queue[0]
+		queue = queue[1:]
+		children, diags := getLineageChildren(ctx, client, level.id, includeReplicas)
+		if diags != nil {
+			return diags
+		}
+		for _, child := range children {
+			if visited[child.id] {
+				continue
+			}
+			visited[child.id] = true
+			descendantIds = append(descendantIds, child.id)
+			descendants = append(descendants, flattenLineageDataset(child, level.id, level.depth+1))
+			queue = append(queue, lineageLevel{id: child.id, depth: level.depth + 1})
+		}
+	}
+	tflog.Info(ctx, DLPX+INFO+"Dataset "+datasetId+" lineage resolved")
+
+	d.SetId("lineage:" + datasetId)
+	d.Set("type", dataset.datasetType)
+	d.Set("name", dataset.name)
+	d.Set("engine_id", dataset.engineId)
+	d.Set("engine_name", dataset.engineName)
+	d.Set("status", dataset.status)
+	d.Set("root_id", rootId)
+	d.Set("ancestor_ids", ancestorIds)
+	d.Set("ancestors", ancestors)
+	d.Set("descendant_ids", descendantIds)
+	d.Set("descendants", descendants)
+	return nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasetLineage_vdb_positive(t *testing.T) {
+	name := os.Getenv("LOOKUP_VDB_NAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if name == "" {
+				t.Fatal("LOOKUP_VDB_NAME must be set for dataset lineage acceptance tests")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetLineageConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.delphix_dataset_lineage.vdb", "type", "VDB"),
+					resource.TestCheckResourceAttr("data.delphix_dataset_lineage.vdb", "name", name),
+					resource.TestCheckResourceAttrPair("data.delphix_dataset_lineage.vdb", "ancestor_ids.0", "data.delphix_vdb.single", "parent_id"),
+					resource.TestCheckResourceAttr("data.delphix_dataset_lineage.vdb", "ancestors.0.depth", "1"),
+					resource.TestCheckResourceAttrSet("data.delphix_dataset_lineage.vdb", "root_id")),
+			},
+		},
+	})
+}
+
+func testAccDatasetLineageConfig(name string) string {
+	return fmt.Sprintf(`
+	data "delphix_vdb" "single" {
+		name = "%s"
+	}
+	data "delphix_dataset_lineage" "vdb" {
+		dataset_id = data.delphix_vdb.single.id
+	}
+	`, name)
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDsources() *schema.Resource {
+	dataSchema := lookupFilterSchema()
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dataSchema["dsources"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: dsourceAttributesSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source for searching dSources.",
+
+		ReadContext: dataSourceDsourcesRead,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceDsource() *schema.Resource {
+	dataSchema := lookupFilterSchema()
+	for k, v := range dsourceAttributesSchema() {
+		if _, exists := dataSchema[k]; !exists {
+			dataSchema[k] = v
+		}
+	}
+	dataSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Description: "Data source for looking up a single dSource.",
+
+		ReadContext: dataSourceDsourceRead,
+
+		Schema: dataSchema,
+	}
+}
+
+// dsourceAttributesSchema returns the attributes exported for each dSource.
+func dsourceAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"source_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"staging_source_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"group_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"current_timeflow_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"previous_timeflow_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"plugin_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"creation_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_replica": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_detached": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_appdata": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"tags": computedTagsSchema(),
+	}
+}
+
+func flattenDsource(dsource dctapi.DSource) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   dsource.GetId(),
+		"name":                 dsource.GetName(),
+		"database_type":        dsource.GetDatabaseType(),
+		"database_version":     dsource.GetDatabaseVersion(),
+		"engine_id":            dsource.GetEngineId(),
+		"engine_name":          dsource.GetEngineName(),
+		"source_id":            dsource.GetSourceId(),
+		"staging_source_id":    dsource.GetStagingSourceId(),
+		"status":               dsource.GetStatus(),
+		"group_name":           dsource.GetGroupName(),
+		"current_timeflow_id":  dsource.GetCurrentTimeflowId(),
+		"previous_timeflow_id": dsource.GetPreviousTimeflowId(),
+		"plugin_version":       dsource.GetPluginVersion(),
+		"creation_date":        dsource.GetCreationDate().String(),
+		"is_replica":           dsource.GetIsReplica(),
+		"enabled":              dsource.GetEnabled(),
+		"is_detached":          dsource.GetIsDetached(),
+		"is_appdata":           dsource.GetIsAppdata(),
+		"tags":                 flattenTags(dsource.GetTags()),
+	}
+}
+
+func dataSourceDsourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	filter := lookupFilterExpression(d)
+	tflog.Info(ctx, DLPX+INFO+"Searching dSources with filter: "+filter)
+	dsources, diags := searchDsources(ctx, client, filter)
+	if diags != nil {
+		return diags
+	}
+
+	ids := make([]string, len(dsources))
+	items := make([]interface{}, len(dsources))
+	for i, dsource := range dsources {
+		ids[i] = dsource.GetId()
+		items[i] = flattenDsource(dsource)
+	}
+
+	d.SetId("dsources:" + filter)
+	d.Set("ids", ids)
+	d.Set("dsources", items)
+	return nil
+}
+
+func dataSourceDsourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	var dsource dctapi.DSource
+	if v, has_v := d.GetOk("id"); has_v {
+		res, httpRes, err := client.DSourcesAPI.GetDsourceById(ctx, v.(string)).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		dsource = *res
+	} else {
+		filter := lookupFilterExpression(d)
+		tflog.Info(ctx, DLPX+INFO+"Searching dSources with filter: "+filter)
+		dsources, diags := searchDsources(ctx, client, filter)
+		if diags != nil {
+			return diags
+		}
+		if len(dsources) == 0 {
+			return diag.Errorf("no dSource matches the filter '%s'.", filter)
+		}
+		if len(dsources) > 1 {
+			return diag.Errorf("%d dSources match the filter '%s'. Narrow the search.", len(dsources), filter)
+		}
+		dsource = dsources[0]
+	}
+
+	d.SetId(dsource.GetId())
+	for k, v := range flattenDsource(dsource) {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsources_lookup_positive(t *testing.T) {
+	name := os.Getenv("LOOKUP_DSOURCE_NAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if name == "" {
+				t.Fatal("LOOKUP_DSOURCE_NAME must be set for dsource lookup acceptance tests")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsourcesLookupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.delphix_dsource.single", "name", name),
+					resource.TestCheckResourceAttrSet("data.delphix_dsource.single", "current_timeflow_id"),
+					resource.TestCheckResourceAttrPair("data.delphix_dsources.all", "ids.0", "data.delphix_dsource.single", "id")),
+			},
+		},
+	})
+}
+
+func testAccDsourcesLookupConfig(name string) string {
+	return fmt.Sprintf(`
+	data "delphix_dsource" "single" {
+		name = "%s"
+	}
+	data "delphix_dsources" "all" {
+		filter = "id eq '${data.delphix_dsource.single.id}'"
+	}
+	`, name)
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEngines() *schema.Resource {
+	dataSchema := engineFilterSchema()
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dataSchema["engines"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: engineAttributesSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source for searching the engines registered with DCT.",
+
+		ReadContext: dataSourceEnginesRead,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceEngine() *schema.Resource {
+	dataSchema := engineFilterSchema()
+	for k, v := range engineAttributesSchema() {
+		if _, exists := dataSchema[k]; !exists {
+			dataSchema[k] = v
+		}
+	}
+	dataSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Description: "Data source for looking up a single engine registered with DCT.",
+
+		ReadContext: dataSourceEngineRead,
+
+		Schema: dataSchema,
+	}
+}
+
+// engineFilterSchema returns the arguments used to search engines.
+func engineFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"hostname": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{EngineTypeVirtualization, EngineTypeMasking}, false),
+		},
+		"filter_tags": filterTagsSchema(),
+	}
+}
+
+// engineAttributesSchema returns the attributes exported for each engine.
+func engineAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"uuid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"hostname": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"connection_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cpu_core_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"memory_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"data_storage_capacity": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"data_storage_used": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"tags": computedTagsSchema(),
+	}
+}
+
+// engineFilterExpression builds the DCT filter expression from the engine search arguments.
+func engineFilterExpression(d *schema.ResourceData) string {
+	conditions := []string{}
+	if v, has_v := d.GetOk("name"); has_v {
+		conditions = append(conditions, "name eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("hostname"); has_v {
+		conditions = append(conditions, "hostname eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("type"); has_v {
+		conditions = append(conditions, "type eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("filter_tags"); has_v {
+		conditions = append(conditions, tagsFilterConditions(toTagArray(v))...)
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func flattenEngine(engine dctapi.RegisteredEngine) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    engine.GetId(),
+		"uuid":                  engine.GetUuid(),
+		"name":                  engine.GetName(),
+		"hostname":              engine.GetHostname(),
+		"type":                  engine.GetType(),
+		"version":               engine.GetVersion(),
+		"status":                engine.GetStatus(),
+		"connection_status":     engine.GetConnectionStatus(),
+		"cpu_core_count":        engine.GetCpuCoreCount(),
+		"memory_size":           engine.GetMemorySize(),
+		"data_storage_capacity": engine.GetDataStorageCapacity(),
+		"data_storage_used":     engine.GetDataStorageUsed(),
+		"tags":                  flattenTags(engine.GetTags()),
+	}
+}
+
+func dataSourceEnginesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	filter := engineFilterExpression(d)
+	tflog.Info(ctx, DLPX+INFO+"Searching engines with filter: "+filter)
+	engines, diags := searchEngines(ctx, client, filter)
+	if diags != nil {
+		return diags
+	}
+
+	ids := make([]string, len(engines))
+	items := make([]interface{}, len(engines))
+	for i, engine := range engines {
+		ids[i] = engine.GetId()
+		items[i] = flattenEngine(engine)
+	}
+
+	d.SetId("engines:" + filter)
+	d.Set("ids", ids)
+	d.Set("engines", items)
+	return nil
+}
+
+func dataSourceEngineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	var engine dctapi.RegisteredEngine
+	if v, has_v := d.GetOk("id"); has_v {
+		res, httpRes, err := client.ManagementAPI.GetRegisteredEngine(ctx, v.(string)).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		engine = *res
+	} else {
+		filter := engineFilterExpression(d)
+		tflog.Info(ctx, DLPX+INFO+"Searching engines with filter: "+filter)
+		engines, diags := searchEngines(ctx, client, filter)
+		if diags != nil {
+			return diags
+		}
+		if len(engines) == 0 {
+			return diag.Errorf("no engine matches the filter '%s'.", filter)
+		}
+		if len(engines) > 1 {
+			return diag.Errorf("%d engines match the filter '%s'. Narrow the search.", len(engines), filter)
+		}
+		engine = engines[0]
+	}
+
+	d.SetId(engine.GetId())
+	for k, v := range flattenEngine(engine) {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEngines_lookup_positive(t *testing.T) {
+	engineName := os.Getenv("ENGINE_NAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccEnginesPreCheck(t, engineName) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnginesConfig(engineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.delphix_engines.all", "ids.0"),
+					resource.TestCheckResourceAttr("data.delphix_engine.by_name", "name", engineName),
+					resource.TestCheckResourceAttrSet("data.delphix_engine.by_name", "version"),
+					resource.TestCheckResourceAttrPair("data.delphix_engine.by_id", "hostname", "data.delphix_engine.by_name", "hostname")),
+			},
+		},
+	})
+}
+
+func testAccEnginesPreCheck(t *testing.T, engineName string) {
+	testAccPreCheck(t)
+	if engineName == "" {
+		t.Fatal("ENGINE_NAME must be set for engine acceptance tests")
+	}
+}
+
+func testAccEnginesConfig(engineName string) string {
+	return fmt.Sprintf(`
+	data "delphix_engines" "all" {
+	}
+	data "delphix_engine" "by_name" {
+		name = "%s"
+	}
+	data "delphix_engine" "by_id" {
+		id = data.delphix_engine.by_name.id
+	}
+	`, engineName)
+}
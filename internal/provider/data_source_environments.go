@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEnvironments() *schema.Resource {
+	dataSchema := lookupFilterSchema()
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dataSchema["environments"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: environmentAttributesSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source for searching environments.",
+
+		ReadContext: dataSourceEnvironmentsRead,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceEnvironment() *schema.Resource {
+	dataSchema := lookupFilterSchema()
+	for k, v := range environmentAttributesSchema() {
+		if _, exists := dataSchema[k]; !exists {
+			dataSchema[k] = v
+		}
+	}
+	dataSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Description: "Data source for looking up a single environment.",
+
+		ReadContext: dataSourceEnvironmentRead,
+
+		Schema: dataSchema,
+	}
+}
+
+// environmentAttributesSchema returns the attributes exported for each environment.
+func environmentAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"namespace": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_cluster": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_windows_target": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"hosts": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostname": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"os_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"os_version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"memory_size": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"repositories": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"database_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"allow_provisioning": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"is_staging": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"tags": computedTagsSchema(),
+	}
+}
+
+func flattenEnvironment(env dctapi.Environment) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                env.GetId(),
+		"name":              env.GetName(),
+		"engine_id":         env.GetEngineId(),
+		"namespace":         env.GetNamespace(),
+		"enabled":           env.GetEnabled(),
+		"is_cluster":        env.GetIsCluster(),
+		"is_windows_target": env.GetIsWindowsTarget(),
+		"hosts":             flattenHosts(env.GetHosts()),
+		"repositories":      flattenHostRepositories(env.GetRepositories()),
+		"tags":              flattenTags(env.GetTags()),
+	}
+}
+
+func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	filter := lookupFilterExpression(d)
+	tflog.Info(ctx, DLPX+INFO+"Searching environments with filter: "+filter)
+	envs, diags := searchEnvironments(ctx, client, filter)
+	if diags != nil {
+		return diags
+	}
+
+	ids := make([]string, len(envs))
+	items := make([]interface{}, len(envs))
+	for i, env := range envs {
+		ids[i] = env.GetId()
+		items[i] = flattenEnvironment(env)
+	}
+
+	d.SetId("environments:" + filter)
+	d.Set("ids", ids)
+	d.Set("environments", items)
+	return nil
+}
+
+func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	var env dctapi.Environment
+	if v, has_v := d.GetOk("id"); has_v {
+		res, httpRes, err := client.EnvironmentsAPI.GetEnvironmentById(ctx, v.(string)).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		env = *res
+	} else {
+		filter := lookupFilterExpression(d)
+		tflog.Info(ctx, DLPX+INFO+"Searching environments with filter: "+filter)
+		envs, diags := searchEnvironments(ctx, client, filter)
+		if diags != nil {
+			return diags
+		}
+		if len(envs) == 0 {
+			return diag.Errorf("no environment matches the filter '%s'.", filter)
+		}
+		if len(envs) > 1 {
+			return diag.Errorf("%d environments match the filter '%s'. Narrow the search.", len(envs), filter)
+		}
+		env = envs[0]
+	}
+
+	d.SetId(env.GetId())
+	for k, v := range flattenEnvironment(env) {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+	return nil
+}
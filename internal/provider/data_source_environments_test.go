@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEnvironments_lookup_positive(t *testing.T) {
+	name := os.Getenv("LOOKUP_ENVIRONMENT_NAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if name == "" {
+				t.Fatal("LOOKUP_ENVIRONMENT_NAME must be set for environment lookup acceptance tests")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentsLookupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.delphix_environment.single", "name", name),
+					resource.TestCheckResourceAttrSet("data.delphix_environment.single", "hosts.0.hostname"),
+					resource.TestCheckResourceAttrPair("data.delphix_environments.all", "ids.0", "data.delphix_environment.single", "id")),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentsLookupConfig(name string) string {
+	return fmt.Sprintf(`
+	data "delphix_environment" "single" {
+		name = "%s"
+	}
+	data "delphix_environments" "all" {
+		filter = "id eq '${data.delphix_environment.single.id}'"
+	}
+	`, name)
+}
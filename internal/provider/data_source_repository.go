@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRepository() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a repository of an environment.",
+
+		ReadContext: dataSourceRepositoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"database_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"version_constraint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if _, err := version.NewConstraint(v.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q is not a valid version constraint: %s", k, err.Error()))
+					}
+					return
+				},
+			},
+			"allow_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Output
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// repositoryMatches returns whether the repository matches the configured lookup arguments, other than
+// the version constraint.
+func repositoryMatches(d *schema.ResourceData, repo dctapi.Repository) bool {
+	if v, has_v := d.GetOk("name"); has_v && repo.GetName() != v.(string) {
+		return false
+	}
+	if v, has_v := d.GetOk("database_type"); has_v && repo.GetDatabaseType() != v.(string) {
+		return false
+	}
+	if v, has_v := d.GetOkExists("allow_provisioning"); has_v && repo.GetAllowProvisioning() != v.(bool) {
+		return false
+	}
+	if v, has_v := d.GetOkExists("is_staging"); has_v && repo.GetIsStaging() != v.(bool) {
+		return false
+	}
+	return true
+}
+
+func dataSourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	envId := d.Get("environment_id").(string)
+
+	env, httpRes, err := client.EnvironmentsAPI.GetEnvironmentById(ctx, envId).Execute()
+	if diags := apiErrorResponseHelper(ctx, env, httpRes, err); diags != nil {
+		return diags
+	}
+
+	var constraint version.Constraints
+	if v, has_v := d.GetOk("version_constraint"); has_v {
+		constraint, _ = version.NewConstraint(v.(string))
+	}
+
+	var matches []dctapi.Repository
+	var versions []*version.Version
+	for _, repo := range env.GetRepositories() {
+		if !repositoryMatches(d, repo) {
+			continue
+		}
+		repoVersion, err := version.NewVersion(repo.GetVersion())
+		if err != nil {
+			if constraint != nil || d.Get("most_recent").(bool) {
+				tflog.Warn(ctx, DLPX+WARN+"Skipping repository "+repo.GetId()+" with unparsable version "+repo.GetVersion())
+				continue
+			}
+		} else if constraint != nil && !constraint.Check(repoVersion) {
+			continue
+		}
+		matches = append(matches, repo)
+		versions = append(versions, repoVersion)
+	}
+
+	if len(matches) == 0 {
+		return diag.Errorf("no repository of environment %s matches the lookup arguments.", envId)
+	}
+	selected := 0
+	if len(matches) > 1 {
+		if !d.Get("most_recent").(bool) {
+			return diag.Errorf("%d repositories of environment %s match the lookup arguments. Narrow the search or set most_recent = true.", len(matches), envId)
+		}
+		for i := range matches {
+			if versions[i].GreaterThan(versions[selected]) {
+				selected = i
+			}
+		}
+	}
+
+	repo := matches[selected]
+	d.SetId(repo.GetId())
+	d.Set("name", repo.GetName())
+	d.Set("database_type", repo.GetDatabaseType())
+	d.Set("version", repo.GetVersion())
+	d.Set("allow_provisioning", repo.GetAllowProvisioning())
+	d.Set("is_staging", repo.GetIsStaging())
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRepository_lookup_positive(t *testing.T) {
+	envId := os.Getenv("REPOSITORY_ENVIRONMENT_ID")
+	databaseType := os.Getenv("REPOSITORY_DATABASE_TYPE")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccRepositoryPreCheck(t, envId, databaseType) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryConfig(envId, databaseType),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.delphix_repository.newest", "id"),
+					resource.TestCheckResourceAttrSet("data.delphix_repository.newest", "version"),
+					resource.TestCheckResourceAttr("data.delphix_repository.newest", "database_type", databaseType)),
+			},
+		},
+	})
+}
+
+func testAccRepositoryPreCheck(t *testing.T, envId string, databaseType string) {
+	testAccPreCheck(t)
+	if envId == "" {
+		t.Fatal("REPOSITORY_ENVIRONMENT_ID must be set for repository acceptance tests")
+	}
+	if databaseType == "" {
+		t.Fatal("REPOSITORY_DATABASE_TYPE must be set for repository acceptance tests")
+	}
+}
+
+func testAccRepositoryConfig(envId string, databaseType string) string {
+	return fmt.Sprintf(`
+	data "delphix_repository" "newest" {
+		environment_id     = "%s"
+		database_type      = "%s"
+		version_constraint = ">= 0.1"
+		most_recent        = true
+	}
+	`, envId, databaseType)
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// searchObjectTypes maps the object types supported by the delphix_search data source to the page
+// function of their DCT search endpoint.
+var searchObjectTypes = map[string]searchPageFunc[interface{}]{
+	"bookmarks":    anySearchPage(searchBookmarksPage),
+	"dsources":     anySearchPage(searchDsourcesPage),
+	"engines":      anySearchPage(searchEnginesPage),
+	"environments": anySearchPage(searchEnvironmentsPage),
+	"jobs":         anySearchPage(searchJobsPage),
+	"snapshots":    anySearchPage(searchSnapshotsPage),
+	"sources":      anySearchPage(searchSourcesPage),
+	"timeflows":    anySearchPage(searchTimeflowsPage),
+	"vdb_groups":   anySearchPage(searchVdbGroupsPage),
+	"vdbs":         anySearchPage(searchVdbsPage),
+}
+
+// anySearchPage adapts the page function of a DCT object type to the untyped search results.
+func anySearchPage[T any](page searchPageFunc[T]) searchPageFunc[interface{}] {
+	return func(ctx context.Context, client *dctapi.APIClient, body dctapi.SearchBody, sortBy string, cursor string) ([]interface{}, string, diag.Diagnostics) {
+		pageItems, next, diags := page(ctx, client, body, sortBy, cursor)
+		if diags != nil {
+			return nil, "", diags
+		}
+		items := make([]interface{}, len(pageItems))
+		for i, item := range pageItems {
+			items[i] = item
+		}
+		return items, next, nil
+	}
+}
+
+// searchBookmarksPage returns one page of the bookmarks matching the search body.
+var searchBookmarksPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchBookmarksRequest {
+	return client.BookmarksAPI.SearchBookmarks(ctx)
+})
+
+// searchJobsPage returns one page of the jobs matching the search body.
+var searchJobsPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchJobsRequest {
+	return client.JobsAPI.SearchJobs(ctx)
+})
+
+// searchVdbGroupsPage returns one page of the VDB groups matching the search body.
+var searchVdbGroupsPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchVdbGroupsRequest {
+	return client.VDBGroupsAPI.SearchVdbGroups(ctx)
+})
+
+func dataSourceSearch() *schema.Resource {
+	objectTypes := make([]string, 0, len(searchObjectTypes))
+	for k := range searchObjectTypes {
+		objectTypes = append(objectTypes, k)
+	}
+	sort.Strings(objectTypes)
+
+	return &schema.Resource{
+		Description: "Data source for searching any type of DCT object with a filter expression.",
+
+		ReadContext: dataSourceSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(objectTypes, false),
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			// Output
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"json": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenSearchResult returns the search result attributes of a DCT object, from its JSON encoding.
+func flattenSearchResult(item interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	decoded := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, err
+	}
+	attributes, err := flattenJsonParameterMap(decoded)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"id":         attributes["id"],
+		"attributes": attributes,
+		"json":       string(raw),
+	}, nil
+}
+
+func dataSourceSearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	objectType := d.Get("object_type").(string)
+	filter := d.Get("filter").(string)
+	sortBy := d.Get("sort").(string)
+	limit := d.Get("limit").(int)
+
+	searchBody := dctapi.NewSearchBody()
+	if filter != "" {
+		searchBody.SetFilterExpression(filter)
+	}
+
+	tflog.Info(ctx, DLPX+INFO+"Searching "+objectType+" with filter: "+filter)
+	items, diags := paginate(ctx, client, searchObjectTypes[objectType], *searchBody, sortBy, limit)
+	if diags != nil {
+		return diags
+	}
+
+	ids := make([]string, len(items))
+	results := make([]interface{}, len(items))
+	for i, item := range items {
+		result, err := flattenSearchResult(item)
+		if err != nil {
+			return diag.Errorf("unable to decode %s search result: %s", objectType, err.Error())
+		}
+		ids[i] = result["id"].(string)
+		results[i] = result
+	}
+
+	d.SetId(strings.Join([]string{"search", objectType, filter, sortBy}, ":"))
+	d.Set("ids", ids)
+	d.Set("results", results)
+	return nil
+}
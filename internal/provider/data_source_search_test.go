@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSearch_vdbs_positive(t *testing.T) {
+	name := os.Getenv("LOOKUP_VDB_NAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if name == "" {
+				t.Fatal("LOOKUP_VDB_NAME must be set for search acceptance tests")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSearchConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.delphix_search.vdbs", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.delphix_search.vdbs", "results.0.attributes.name", name),
+					resource.TestCheckResourceAttrSet("data.delphix_search.vdbs", "results.0.json"),
+					resource.TestCheckResourceAttrPair("data.delphix_search.vdbs", "ids.0", "data.delphix_search.vdbs", "results.0.id")),
+			},
+		},
+	})
+}
+
+func TestAccSearch_object_type_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "delphix_search" "unknown" {
+					object_type = "unknown"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*expected object_type to be one of.*`),
+			},
+		},
+	})
+}
+
+func testAccSearchConfig(name string) string {
+	return fmt.Sprintf(`
+	data "delphix_search" "vdbs" {
+		object_type = "vdbs"
+		filter      = "name eq '%s'"
+		limit       = 1
+	}
+	`, name)
+}
+
+func TestPaginate(t *testing.T) {
+	// three pages of two items, the cursor is the index of the next page.
+	page := func(ctx context.Context, client *dctapi.APIClient, body dctapi.SearchBody, sortBy string, cursor string) ([]int, string, diag.Diagnostics) {
+		index := 0
+		if cursor != "" {
+			index, _ = strconv.Atoi(cursor)
+		}
+		next := ""
+		if index < 2 {
+			next = strconv.Itoa(index + 1)
+		}
+		return []int{2 * index, 2*index + 1}, next, nil
+	}
+
+	items, diags := paginate(context.Background(), nil, page, dctapi.SearchBody{}, "", 0)
+	if diags != nil || len(items) != 6 || items[5] != 5 {
+		t.Errorf("unexpected items %v, diagnostics %v", items, diags)
+	}
+	items, diags = paginate(context.Background(), nil, page, dctapi.SearchBody{}, "", 3)
+	if diags != nil || len(items) != 3 || items[2] != 2 {
+		t.Errorf("unexpected limited items %v, diagnostics %v", items, diags)
+	}
+}
+
+// testSearchRequest is a search request returning two pages of the sort key and cursor it was sent.
+type testSearchRequest struct {
+	sortBy string
+	cursor string
+}
+
+type testSearchResponse struct {
+	items    []string
+	metadata dctapi.PaginatedResponseMetadata
+}
+
+func (r testSearchRequest) SearchBody(body dctapi.SearchBody) testSearchRequest { return r }
+func (r testSearchRequest) Sort(sort string) testSearchRequest                  { r.sortBy = sort; return r }
+func (r testSearchRequest) Cursor(cursor string) testSearchRequest              { r.cursor = cursor; return r }
+func (r testSearchRequest) Execute() (*testSearchResponse, *http.Response, error) {
+	res := &testSearchResponse{items: []string{r.sortBy + "/" + r.cursor}}
+	if r.cursor == "" {
+		res.metadata.SetNextCursor("next")
+	}
+	return res, nil, nil
+}
+
+func (r *testSearchResponse) GetItems() []string { return r.items }
+func (r *testSearchResponse) GetResponseMetadata() dctapi.PaginatedResponseMetadata {
+	return r.metadata
+}
+
+func TestSearchPage(t *testing.T) {
+	page := searchPage(func(ctx context.Context, client *dctapi.APIClient) testSearchRequest {
+		return testSearchRequest{}
+	})
+
+	items, diags := paginate(context.Background(), nil, page, dctapi.SearchBody{}, "name", 0)
+	if diags != nil || len(items) != 2 || items[0] != "name/" || items[1] != "name/next" {
+		t.Errorf("unexpected items %v, diagnostics %v", items, diags)
+	}
+}
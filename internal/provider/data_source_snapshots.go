@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSnapshots() *schema.Resource {
+	dataSchema := snapshotFilterSchema()
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dataSchema["snapshots"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: snapshotAttributesSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source for searching snapshots.",
+
+		ReadContext: dataSourceSnapshotsRead,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceSnapshot() *schema.Resource {
+	dataSchema := snapshotFilterSchema()
+	for k, v := range snapshotAttributesSchema() {
+		if _, exists := dataSchema[k]; !exists {
+			dataSchema[k] = v
+		}
+	}
+	dataSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Description: "Data source for looking up a single snapshot.",
+
+		ReadContext: dataSourceSnapshotRead,
+
+		Schema: dataSchema,
+	}
+}
+
+// snapshotFilterSchema returns the arguments used to search snapshots.
+func snapshotFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dataset_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"timeflow_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"timestamp_after": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"timestamp_before": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"expiration_after": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"expiration_before": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"retain_forever": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"filter_tags": filterTagsSchema(),
+		"most_recent": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// snapshotAttributesSchema returns the attributes exported for each snapshot.
+func snapshotAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"dataset_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"timeflow_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"timestamp": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"start_timestamp": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"creation_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"location": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"timezone": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"retention": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"expiration": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"retain_forever": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"tags": computedTagsSchema(),
+	}
+}
+
+// snapshotFilterExpression builds the DCT filter expression from the snapshot search arguments.
+func snapshotFilterExpression(d *schema.ResourceData) string {
+	conditions := []string{}
+	if v, has_v := d.GetOk("dataset_id"); has_v {
+		conditions = append(conditions, "dataset_id eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("timeflow_id"); has_v {
+		conditions = append(conditions, "timeflow_id eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("timestamp_after"); has_v {
+		conditions = append(conditions, "timestamp gt "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("timestamp_before"); has_v {
+		conditions = append(conditions, "timestamp lt "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("expiration_after"); has_v {
+		conditions = append(conditions, "expiration gt "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("expiration_before"); has_v {
+		conditions = append(conditions, "expiration lt "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOkExists("retain_forever"); has_v {
+		if v.(bool) {
+			conditions = append(conditions, "retain_forever eq true")
+		} else {
+			conditions = append(conditions, "retain_forever eq false")
+		}
+	}
+	if v, has_v := d.GetOk("filter_tags"); has_v {
+		conditions = append(conditions, tagsFilterConditions(toTagArray(v))...)
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func flattenSnapshot(snapshot dctapi.Snapshot) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              snapshot.GetId(),
+		"name":            snapshot.GetName(),
+		"dataset_id":      snapshot.GetDatasetId(),
+		"engine_id":       snapshot.GetEngineId(),
+		"timeflow_id":     snapshot.GetTimeflowId(),
+		"timestamp":       snapshot.GetTimestamp().Format(time.RFC3339Nano),
+		"start_timestamp": snapshot.GetStartTimestamp().Format(time.RFC3339Nano),
+		"creation_time":   snapshot.GetCreationTime().Format(time.RFC3339Nano),
+		"location":        snapshot.GetLocation(),
+		"timezone":        snapshot.GetTimezone(),
+		"retention":       snapshot.GetRetention(),
+		"expiration":      snapshot.GetExpiration(),
+		"retain_forever":  snapshot.GetRetainForever(),
+		"tags":            flattenTags(snapshot.GetTags()),
+	}
+}
+
+// findSnapshots returns the snapshots matching the search arguments, or only the latest one when
+// most_recent is set.
+func findSnapshots(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient) (string, []dctapi.Snapshot, diag.Diagnostics) {
+	filter := snapshotFilterExpression(d)
+	tflog.Info(ctx, DLPX+INFO+"Searching snapshots with filter: "+filter)
+	if d.Get("most_recent").(bool) {
+		snapshot, diags := searchLatestSnapshot(ctx, client, filter)
+		if diags != nil || snapshot == nil {
+			return filter, nil, diags
+		}
+		return filter, []dctapi.Snapshot{*snapshot}, nil
+	}
+	snapshots, diags := searchSnapshots(ctx, client, filter)
+	return filter, snapshots, diags
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	filter, snapshots, diags := findSnapshots(ctx, d, client)
+	if diags != nil {
+		return diags
+	}
+
+	ids := make([]string, len(snapshots))
+	items := make([]interface{}, len(snapshots))
+	for i, snapshot := range snapshots {
+		ids[i] = snapshot.GetId()
+		items[i] = flattenSnapshot(snapshot)
+	}
+
+	d.SetId("snapshots:" + filter)
+	d.Set("ids", ids)
+	d.Set("snapshots", items)
+	return nil
+}
+
+func dataSourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	var snapshot dctapi.Snapshot
+	if v, has_v := d.GetOk("id"); has_v {
+		res, httpRes, err := client.SnapshotsAPI.GetSnapshotById(ctx, v.(string)).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		snapshot = *res
+	} else {
+		filter, snapshots, diags := findSnapshots(ctx, d, client)
+		if diags != nil {
+			return diags
+		}
+		if len(snapshots) == 0 {
+			return diag.Errorf("no snapshot matches the filter '%s'.", filter)
+		}
+		if len(snapshots) > 1 {
+			return diag.Errorf("%d snapshots match the filter '%s'. Narrow the search or set most_recent = true.", len(snapshots), filter)
+		}
+		snapshot = snapshots[0]
+	}
+
+	d.SetId(snapshot.GetId())
+	for k, v := range flattenSnapshot(snapshot) {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+	return nil
+}
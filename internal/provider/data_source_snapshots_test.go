@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSnapshots_search_positive(t *testing.T) {
+	datasetId := os.Getenv("SNAPSHOT_DATASET_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccSnapshotsPreCheck(t, datasetId) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotsConfig(datasetId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.delphix_snapshots.all", "ids.0"),
+					resource.TestCheckResourceAttr("data.delphix_snapshots.all", "snapshots.0.dataset_id", datasetId),
+					resource.TestCheckResourceAttr("data.delphix_snapshot.latest", "dataset_id", datasetId),
+					resource.TestCheckResourceAttrSet("data.delphix_snapshot.latest", "timestamp"),
+					resource.TestCheckResourceAttrPair("data.delphix_snapshot.by_id", "timestamp", "data.delphix_snapshot.latest", "timestamp")),
+			},
+		},
+	})
+}
+
+func testAccSnapshotsPreCheck(t *testing.T, datasetId string) {
+	testAccPreCheck(t)
+	if datasetId == "" {
+		t.Fatal("SNAPSHOT_DATASET_ID must be set for snapshot acceptance tests")
+	}
+}
+
+func testAccSnapshotsConfig(datasetId string) string {
+	return fmt.Sprintf(`
+	data "delphix_snapshots" "all" {
+		dataset_id = "%s"
+	}
+	data "delphix_snapshot" "latest" {
+		dataset_id  = "%s"
+		most_recent = true
+	}
+	data "delphix_snapshot" "by_id" {
+		id = data.delphix_snapshot.latest.id
+	}
+	`, datasetId, datasetId)
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTimeflows() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the timeflows of a dSource or VDB.",
+
+		ReadContext: dataSourceTimeflowsRead,
+
+		Schema: map[string]*schema.Schema{
+			"dataset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timeflows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"activation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_snapshot_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_timeflow_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_vdb_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_dsource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_data_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_timeflow_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"tags": computedTagsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTimeflowRanges() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the provisionable time ranges of a dSource or VDB.",
+
+		ReadContext: dataSourceTimeflowRangesRead,
+
+		Schema: map[string]*schema.Schema{
+			"dataset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"timeflow_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"earliest_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeflow_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisionable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTimeflowsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	datasetId := d.Get("dataset_id").(string)
+
+	timeflows, diags := searchTimeflows(ctx, client, "dataset_id eq "+filterLiteral(datasetId))
+	if diags != nil {
+		return diags
+	}
+
+	// the parent of a timeflow is the timeflow of the snapshot it was created from.
+	parentSnapshotIds := []string{}
+	for _, timeflow := range timeflows {
+		if timeflow.GetParentSnapshotId() != "" {
+			parentSnapshotIds = append(parentSnapshotIds, filterLiteral(timeflow.GetParentSnapshotId()))
+		}
+	}
+	snapshotTimeflows := map[string]string{}
+	if len(parentSnapshotIds) != 0 {
+		snapshots, diags := searchSnapshots(ctx, client, "id in ["+strings.Join(parentSnapshotIds, ", ")+"]")
+		if diags != nil {
+			return diags
+		}
+		for _, snapshot := range snapshots {
+			snapshotTimeflows[snapshot.GetId()] = snapshot.GetTimeflowId()
+		}
+	}
+	children := map[string][]string{}
+	for _, timeflow := range timeflows {
+		if parent := snapshotTimeflows[timeflow.GetParentSnapshotId()]; parent != "" {
+			children[parent] = append(children[parent], timeflow.GetId())
+		}
+	}
+
+	ids := make([]string, len(timeflows))
+	items := make([]interface{}, len(timeflows))
+	for i, timeflow := range timeflows {
+		ids[i] = timeflow.GetId()
+		items[i] = map[string]interface{}{
+			"id":                    timeflow.GetId(),
+			"name":                  timeflow.GetName(),
+			"engine_id":             timeflow.GetEngineId(),
+			"creation_type":         timeflow.GetCreationType(),
+			"activation_timestamp":  timeflow.GetActivationTimestamp().Format(time.RFC3339Nano),
+			"timezone":              timeflow.GetTimezone(),
+			"parent_snapshot_id":    timeflow.GetParentSnapshotId(),
+			"parent_timeflow_id":    snapshotTimeflows[timeflow.GetParentSnapshotId()],
+			"parent_vdb_id":         timeflow.GetParentVdbId(),
+			"parent_dsource_id":     timeflow.GetParentDsourceId(),
+			"source_data_timestamp": timeflow.GetSourceDataTimestamp().Format(time.RFC3339Nano),
+			"child_timeflow_ids":    children[timeflow.GetId()],
+			"tags":                  flattenTags(timeflow.GetTags()),
+		}
+	}
+
+	d.SetId(datasetId)
+	d.Set("ids", ids)
+	d.Set("timeflows", items)
+	return nil
+}
+
+func dataSourceTimeflowRangesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	datasetId := d.Get("dataset_id").(string)
+	timeflowId := d.Get("timeflow_id").(string)
+
+	ranges, diags := getDatasetTimeflowRanges(ctx, client, datasetId, timeflowId)
+	if diags != nil {
+		return diags
+	}
+
+	var earliest, latest time.Time
+	items := make([]interface{}, len(ranges))
+	for i, r := range ranges {
+		items[i] = flattenTimeflowRange(r)
+		if !r.GetProvisionable() {
+			continue
+		}
+		if earliest.IsZero() || r.GetStartTimestamp().Before(earliest) {
+			earliest = r.GetStartTimestamp()
+		}
+		if latest.IsZero() || r.GetEndTimestamp().After(latest) {
+			latest = r.GetEndTimestamp()
+		}
+	}
+
+	d.SetId(datasetId + ":" + timeflowId)
+	d.Set("ranges", items)
+	if !earliest.IsZero() {
+		d.Set("earliest_timestamp", earliest.Format(time.RFC3339Nano))
+		d.Set("latest_timestamp", latest.Format(time.RFC3339Nano))
+	}
+	return nil
+}
+
+func flattenTimeflowRange(r datasetTimeflowRange) map[string]interface{} {
+	return map[string]interface{}{
+		"timeflow_id":     r.timeflowId,
+		"start_timestamp": r.GetStartTimestamp().Format(time.RFC3339Nano),
+		"end_timestamp":   r.GetEndTimestamp().Format(time.RFC3339Nano),
+		"start_location":  r.GetStartLocation(),
+		"end_location":    r.GetEndLocation(),
+		"provisionable":   r.GetProvisionable(),
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTimeflows_read_positive(t *testing.T) {
+	datasetId := os.Getenv("TIMEFLOW_DATASET_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccTimeflowsPreCheck(t, datasetId) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeflowsConfig(datasetId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.delphix_timeflows.all", "ids.0"),
+					resource.TestCheckResourceAttrSet("data.delphix_timeflow_ranges.all", "ranges.0.start_timestamp"),
+					resource.TestCheckResourceAttrSet("data.delphix_timeflow_ranges.all", "earliest_timestamp"),
+					resource.TestCheckResourceAttrSet("data.delphix_timeflow_ranges.all", "latest_timestamp")),
+			},
+		},
+	})
+}
+
+func testAccTimeflowsPreCheck(t *testing.T, datasetId string) {
+	testAccPreCheck(t)
+	if datasetId == "" {
+		t.Fatal("TIMEFLOW_DATASET_ID must be set for timeflow acceptance tests")
+	}
+}
+
+func testAccTimeflowsConfig(datasetId string) string {
+	return fmt.Sprintf(`
+	data "delphix_timeflows" "all" {
+		dataset_id = "%s"
+	}
+	data "delphix_timeflow_ranges" "all" {
+		dataset_id = "%s"
+	}
+	`, datasetId, datasetId)
+}
+
+func TestFlattenTimeflowRange_fractionalSeconds(t *testing.T) {
+	start := time.Date(2026, 10, 19, 8, 51, 34, 148000000, time.UTC)
+	end := start.Add(90*time.Minute + 250*time.Millisecond)
+	r := dctapi.TimeflowRange{}
+	r.SetStartTimestamp(start)
+	r.SetEndTimestamp(end)
+	r.SetProvisionable(true)
+	ranges := []datasetTimeflowRange{{timeflowId: "timeflow-1", TimeflowRange: r}}
+
+	flattened := flattenTimeflowRange(ranges[0])
+	for _, key := range []string{"start_timestamp", "end_timestamp"} {
+		timestamp := flattened[key].(string)
+		if err := validateTimestampInRanges(timestamp, "dataset-1", ranges); err != nil {
+			t.Errorf("%s %s does not round-trip: %s", key, timestamp, err)
+		}
+	}
+
+	before := start.Add(-time.Millisecond).Format(time.RFC3339Nano)
+	if err := validateTimestampInRanges(before, "dataset-1", ranges); err == nil {
+		t.Errorf("timestamp %s before the range was accepted", before)
+	}
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vdbProvisionDefaults is implemented by the provisioning defaults returned for snapshots, timestamps
+// and bookmarks.
+type vdbProvisionDefaults interface {
+	GetName() string
+	GetDatabaseName() string
+	GetUniqueName() string
+	GetInstanceName() string
+	GetMountPoint() string
+	GetFileMappingRules() string
+	GetEnvironmentId() string
+	GetEnvironmentUserId() string
+	GetRepositoryId() string
+	GetTargetGroupId() string
+	GetCdbId() string
+	GetVcdbName() string
+	GetVcdbDatabaseName() string
+	GetOpenResetLogs() bool
+	GetOnlineLogSize() int32
+	GetOnlineLogGroups() int32
+	GetArchiveLogMode() bool
+	GetConfigParams() map[string]interface{}
+	GetAppdataSourceParams() map[string]interface{}
+	GetAppdataConfigParams() map[string]interface{}
+}
+
+func dataSourceVdbProvisionDefaults() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"provision_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "snapshot",
+			ValidateFunc: validation.StringInSlice([]string{"snapshot", "timestamp", "bookmark"}, false),
+		},
+		"snapshot_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"source_data_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"timestamp": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsRFC3339Time,
+			ConflictsWith: []string{"timestamp_in_database_timezone"},
+		},
+		"timestamp_in_database_timezone": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"timestamp"},
+		},
+		"bookmark_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"unique_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"instance_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mount_point": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"file_mapping_rules": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"environment_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"environment_user_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repository_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"target_group_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cdb_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vcdb_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vcdb_database_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"open_reset_logs": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"archive_log_mode": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"online_log_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"online_log_groups": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+	// JSON parameters are exported decoded, and as JSON to be passed to delphix_vdb unchanged.
+	for _, k := range []string{"config_params", "appdata_source_params", "appdata_config_params"} {
+		dataSchema[k] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+		dataSchema[k+"_json"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Data source for the defaults DCT suggests to provision a VDB.",
+
+		ReadContext: dataSourceVdbProvisionDefaultsRead,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceVdbProvisionDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	var defaults vdbProvisionDefaults
+	var id string
+	switch d.Get("provision_type").(string) {
+	case "snapshot":
+		v, has_v := d.GetOk("snapshot_id")
+		if !has_v {
+			return diag.Errorf("snapshot_id is required for provision_type = 'snapshot'")
+		}
+		id = v.(string)
+		req := dctapi.NewProvisionVDBBySnapshotDefaultsRequest(id)
+		if v, has_v := d.GetOk("engine_id"); has_v {
+			req.SetEngineId(v.(string))
+		}
+		res, httpRes, err := client.VDBsAPI.ProvisionVdbBySnapshotDefaults(ctx).ProvisionVDBBySnapshotDefaultsRequest(*req).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		defaults = res
+	case "timestamp":
+		v, has_v := d.GetOk("source_data_id")
+		if !has_v {
+			return diag.Errorf("source_data_id is required for provision_type = 'timestamp'")
+		}
+		id = v.(string)
+		req := dctapi.NewProvisionVDBByTimestampDefaultsRequest(id)
+		if v, has_v := d.GetOk("timestamp"); has_v {
+			tt, _ := time.Parse(time.RFC3339, v.(string))
+			req.SetTimestamp(tt)
+			id += ":" + v.(string)
+		}
+		if v, has_v := d.GetOk("timestamp_in_database_timezone"); has_v {
+			req.SetTimestampInDatabaseTimezone(v.(string))
+			id += ":" + v.(string)
+		}
+		if v, has_v := d.GetOk("engine_id"); has_v {
+			req.SetEngineId(v.(string))
+		}
+		res, httpRes, err := client.VDBsAPI.ProvisionVdbByTimestampDefaults(ctx).ProvisionVDBByTimestampDefaultsRequest(*req).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		defaults = res
+	case "bookmark":
+		v, has_v := d.GetOk("bookmark_id")
+		if !has_v {
+			return diag.Errorf("bookmark_id is required for provision_type = 'bookmark'")
+		}
+		id = v.(string)
+		req := dctapi.NewProvisionVDBFromBookmarkDefaultsRequest(id)
+		res, httpRes, err := client.VDBsAPI.ProvisionVdbFromBookmarkDefaults(ctx).ProvisionVDBFromBookmarkDefaultsRequest(*req).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		defaults = res
+	}
+
+	d.SetId(d.Get("provision_type").(string) + ":" + id)
+	d.Set("name", defaults.GetName())
+	d.Set("database_name", defaults.GetDatabaseName())
+	d.Set("unique_name", defaults.GetUniqueName())
+	d.Set("instance_name", defaults.GetInstanceName())
+	d.Set("mount_point", defaults.GetMountPoint())
+	d.Set("file_mapping_rules", defaults.GetFileMappingRules())
+	d.Set("environment_id", defaults.GetEnvironmentId())
+	d.Set("environment_user_id", defaults.GetEnvironmentUserId())
+	d.Set("repository_id", defaults.GetRepositoryId())
+	d.Set("target_group_id", defaults.GetTargetGroupId())
+	d.Set("cdb_id", defaults.GetCdbId())
+	d.Set("vcdb_name", defaults.GetVcdbName())
+	d.Set("vcdb_database_name", defaults.GetVcdbDatabaseName())
+	d.Set("open_reset_logs", defaults.GetOpenResetLogs())
+	d.Set("archive_log_mode", defaults.GetArchiveLogMode())
+	d.Set("online_log_size", defaults.GetOnlineLogSize())
+	d.Set("online_log_groups", defaults.GetOnlineLogGroups())
+
+	params := map[string]map[string]interface{}{
+		"config_params":         defaults.GetConfigParams(),
+		"appdata_source_params": defaults.GetAppdataSourceParams(),
+		"appdata_config_params": defaults.GetAppdataConfigParams(),
+	}
+	for k, v := range params {
+		decoded, err := flattenJsonParameterMap(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set(k, decoded)
+		if len(v) != 0 {
+			raw, err := json.Marshal(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set(k+"_json", string(raw))
+		}
+	}
+	return nil
+}
+
+// flattenJsonParameterMap converts decoded JSON parameters to a map of strings. Strings are kept as
+// is, other values are JSON encoded.
+func flattenJsonParameterMap(params map[string]interface{}) (map[string]string, error) {
+	flattened := make(map[string]string, len(params))
+	for k, v := range params {
+		if s, ok := v.(string); ok {
+			flattened[k] = s
+			continue
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode parameter %s: %s", k, err.Error())
+		}
+		flattened[k] = string(raw)
+	}
+	return flattened, nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVdbProvisionDefaults_snapshot_positive(t *testing.T) {
+	snapshotId := os.Getenv("PROVISION_DEFAULTS_SNAPSHOT_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccVdbProvisionDefaultsPreCheck(t, snapshotId) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVdbProvisionDefaultsConfig(snapshotId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.delphix_vdb_provision_defaults.defaults", "id", "snapshot:"+snapshotId),
+					resource.TestCheckResourceAttrSet("data.delphix_vdb_provision_defaults.defaults", "name"),
+					resource.TestCheckResourceAttrSet("data.delphix_vdb_provision_defaults.defaults", "environment_id")),
+			},
+		},
+	})
+}
+
+func TestAccVdbProvisionDefaults_missing_snapshot_negative(t *testing.T) {
+	snapshotId := os.Getenv("PROVISION_DEFAULTS_SNAPSHOT_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccVdbProvisionDefaultsPreCheck(t, snapshotId) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "delphix_vdb_provision_defaults" "defaults" {
+					provision_type = "snapshot"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*snapshot_id is required.*`),
+			},
+		},
+	})
+}
+
+func testAccVdbProvisionDefaultsPreCheck(t *testing.T, snapshotId string) {
+	testAccPreCheck(t)
+	if snapshotId == "" {
+		t.Fatal("PROVISION_DEFAULTS_SNAPSHOT_ID must be set for VDB provision defaults acceptance tests")
+	}
+}
+
+func testAccVdbProvisionDefaultsConfig(snapshotId string) string {
+	return fmt.Sprintf(`
+	data "delphix_vdb_provision_defaults" "defaults" {
+		provision_type = "snapshot"
+		snapshot_id    = "%s"
+	}
+	`, snapshotId)
+}
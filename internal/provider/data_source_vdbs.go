@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVdbs() *schema.Resource {
+	dataSchema := lookupFilterSchema()
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dataSchema["vdbs"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: vdbAttributesSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source for searching VDBs.",
+
+		ReadContext: dataSourceVdbsRead,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceVdb() *schema.Resource {
+	dataSchema := lookupFilterSchema()
+	for k, v := range vdbAttributesSchema() {
+		if _, exists := dataSchema[k]; !exists {
+			dataSchema[k] = v
+		}
+	}
+	dataSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Description: "Data source for looking up a single VDB.",
+
+		ReadContext: dataSourceVdbRead,
+
+		Schema: dataSchema,
+	}
+}
+
+// vdbAttributesSchema returns the attributes exported for each VDB.
+func vdbAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"environment_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"fqdn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"parent_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"parent_dsource_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"root_parent_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"group_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"creation_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"instance_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"jdbc_connection_string": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cdb_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mount_point": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"current_timeflow_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"previous_timeflow_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_replica": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"vdb_restart": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"tags": computedTagsSchema(),
+	}
+}
+
+func flattenVdb(vdb dctapi.VDB) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                     vdb.GetId(),
+		"name":                   vdb.GetName(),
+		"database_type":          vdb.GetDatabaseType(),
+		"database_version":       vdb.GetDatabaseVersion(),
+		"database_name":          vdb.GetDatabaseName(),
+		"engine_id":              vdb.GetEngineId(),
+		"environment_id":         vdb.GetEnvironmentId(),
+		"ip_address":             vdb.GetIpAddress(),
+		"fqdn":                   vdb.GetFqdn(),
+		"parent_id":              vdb.GetParentId(),
+		"parent_dsource_id":      vdb.GetParentDsourceId(),
+		"root_parent_id":         vdb.GetRootParentId(),
+		"group_name":             vdb.GetGroupName(),
+		"creation_date":          vdb.GetCreationDate().String(),
+		"instance_name":          vdb.GetInstanceName(),
+		"jdbc_connection_string": vdb.GetJdbcConnectionString(),
+		"cdb_id":                 vdb.GetCdbId(),
+		"template_id":            vdb.GetTemplateId(),
+		"mount_point":            vdb.GetMountPoint(),
+		"current_timeflow_id":    vdb.GetCurrentTimeflowId(),
+		"previous_timeflow_id":   vdb.GetPreviousTimeflowId(),
+		"status":                 vdb.GetStatus(),
+		"enabled":                vdb.GetEnabled(),
+		"is_replica":             vdb.GetIsReplica(),
+		"vdb_restart":            vdb.GetVdbRestart(),
+		"tags":                   flattenTags(vdb.GetTags()),
+	}
+}
+
+func dataSourceVdbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	filter := lookupFilterExpression(d)
+	tflog.Info(ctx, DLPX+INFO+"Searching VDBs with filter: "+filter)
+	vdbs, diags := searchVdbs(ctx, client, filter)
+	if diags != nil {
+		return diags
+	}
+
+	ids := make([]string, len(vdbs))
+	items := make([]interface{}, len(vdbs))
+	for i, vdb := range vdbs {
+		ids[i] = vdb.GetId()
+		items[i] = flattenVdb(vdb)
+	}
+
+	d.SetId("vdbs:" + filter)
+	d.Set("ids", ids)
+	d.Set("vdbs", items)
+	return nil
+}
+
+func dataSourceVdbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	var vdb dctapi.VDB
+	if v, has_v := d.GetOk("id"); has_v {
+		res, httpRes, err := client.VDBsAPI.GetVdbById(ctx, v.(string)).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return diags
+		}
+		vdb = *res
+	} else {
+		filter := lookupFilterExpression(d)
+		tflog.Info(ctx, DLPX+INFO+"Searching VDBs with filter: "+filter)
+		vdbs, diags := searchVdbs(ctx, client, filter)
+		if diags != nil {
+			return diags
+		}
+		if len(vdbs) == 0 {
+			return diag.Errorf("no VDB matches the filter '%s'.", filter)
+		}
+		if len(vdbs) > 1 {
+			return diag.Errorf("%d VDBs match the filter '%s'. Narrow the search.", len(vdbs), filter)
+		}
+		vdb = vdbs[0]
+	}
+
+	d.SetId(vdb.GetId())
+	for k, v := range flattenVdb(vdb) {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+	return nil
+}
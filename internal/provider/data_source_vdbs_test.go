@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVdbs_lookup_positive(t *testing.T) {
+	name := os.Getenv("LOOKUP_VDB_NAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if name == "" {
+				t.Fatal("LOOKUP_VDB_NAME must be set for vdb lookup acceptance tests")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVdbsLookupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.delphix_vdb.single", "name", name),
+					resource.TestCheckResourceAttrSet("data.delphix_vdb.single", "parent_id"),
+					resource.TestCheckResourceAttrPair("data.delphix_vdbs.all", "ids.0", "data.delphix_vdb.single", "id")),
+			},
+		},
+	})
+}
+
+func testAccVdbsLookupConfig(name string) string {
+	return fmt.Sprintf(`
+	data "delphix_vdb" "single" {
+		name = "%s"
+	}
+	data "delphix_vdbs" "all" {
+		filter = "id eq '${data.delphix_vdb.single.id}'"
+	}
+	`, name)
+}
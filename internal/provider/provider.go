@@ -57,14 +57,55 @@ func Provider(version string) func() *schema.Provider {
 					Optional: true,
 					Default:  false,
 				},
+				"default_tags": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"delphix_vdb":                 resourceVdb(),
-				"delphix_vdb_group":           resourceVdbGroup(),
-				"delphix_environment":         resourceEnvironment(),
-				"delphix_appdata_dsource":     resourceAppdataDsource(),
-				"delphix_oracle_dsource":      resourceOracleDsource(),
-				"delphix_database_postgresql": resourceSource(),
+				"delphix_vdb":                         resourceVdb(),
+				"delphix_vdb_group":                   resourceVdbGroup(),
+				"delphix_environment":                 resourceEnvironment(),
+				"delphix_environment_repository":      resourceEnvironmentRepository(),
+				"delphix_appdata_dsource":             resourceAppdataDsource(),
+				"delphix_oracle_dsource":              resourceOracleDsource(),
+				"delphix_oracle_staging_push_dsource": resourceOracleStagingPushDsource(),
+				"delphix_mssql_dsource":               resourceMssqlDsource(),
+				"delphix_ase_dsource":                 resourceAseDsource(),
+				"delphix_database_postgresql":         resourceSource(),
+				"delphix_bookmark":                    resourceBookmark(),
+				"delphix_engine_registration":         resourceEngineRegistration(),
+				"delphix_tags":                        resourceTags(),
+			},
+			DataSourcesMap: map[string]*schema.Resource{
+				"delphix_snapshots":              dataSourceSnapshots(),
+				"delphix_snapshot":               dataSourceSnapshot(),
+				"delphix_timeflows":              dataSourceTimeflows(),
+				"delphix_timeflow_ranges":        dataSourceTimeflowRanges(),
+				"delphix_engines":                dataSourceEngines(),
+				"delphix_engine":                 dataSourceEngine(),
+				"delphix_environments":           dataSourceEnvironments(),
+				"delphix_environment":            dataSourceEnvironment(),
+				"delphix_dsources":               dataSourceDsources(),
+				"delphix_dsource":                dataSourceDsource(),
+				"delphix_vdbs":                   dataSourceVdbs(),
+				"delphix_vdb":                    dataSourceVdb(),
+				"delphix_repository":             dataSourceRepository(),
+				"delphix_vdb_provision_defaults": dataSourceVdbProvisionDefaults(),
+				"delphix_dataset_lineage":        dataSourceDatasetLineage(),
+				"delphix_search":                 dataSourceSearch(),
 			},
 		}
 
@@ -76,6 +117,8 @@ func Provider(version string) func() *schema.Provider {
 
 type apiClient struct {
 	client *dctapi.APIClient
+	// defaultTags are merged into the tags of every object the provider tags.
+	defaultTags []dctapi.Tag
 }
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -106,6 +149,6 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diag.FromErr(err)
 		}
 
-		return &apiClient{client}, nil
+		return &apiClient{client, toTagArray(d.Get("default_tags"))}, nil
 	}
 }
@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 
 	dctapi "github.com/delphix/dct-sdk-go/v25"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,6 +23,24 @@ func resourceAppdataDsource() *schema.Resource {
 		ReadContext:   resourceDsourceRead,
 		UpdateContext: resourceDsourceUpdate,
 		DeleteContext: resourceDsourceDelete,
+		CustomizeDiff: customdiff.All(
+			customizeDiffDsourceSync,
+			customizeDiffTagsAll,
+			customizeDiffDsourceUpgrade(func(ctx context.Context, d *schema.ResourceDiff, client *dctapi.APIClient) (string, error) {
+				// the repository of an AppData dSource is installed on the staging environment. staging_environment
+				// may be a name, so the environment id is taken from the staging source of the dSource.
+				dsource, _, err := client.DSourcesAPI.GetDsourceById(ctx, d.Id()).Execute()
+				if err != nil {
+					return "", fmt.Errorf("unable to read dSource %s to validate upgrade_repository_id: %s", d.Id(), err.Error())
+				}
+				stagingSourceId := dsource.GetStagingSourceId()
+				stagingSource, _, err := client.SourcesAPI.GetSourceById(ctx, stagingSourceId).Execute()
+				if err != nil {
+					return "", fmt.Errorf("unable to read staging source %s to validate upgrade_repository_id: %s", stagingSourceId, err.Error())
+				}
+				return stagingSource.GetEnvironmentId(), nil
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -31,6 +52,17 @@ func resourceAppdataDsource() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"rollback_on_failure_phases": rollbackOnFailurePhasesSchema(),
+			"delete_dependents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"source_value": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -42,10 +74,12 @@ func resourceAppdataDsource() *schema.Resource {
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"log_sync_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 			"make_current_account_owner": {
 				Type:     schema.TypeBool,
@@ -87,6 +121,7 @@ func resourceAppdataDsource() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"ops_pre_sync": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -103,7 +138,16 @@ func resourceAppdataDsource() *schema.Resource {
 						"shell": {
 							Type:     schema.TypeString,
 							Optional: true,
-						}, "credentials_env_vars": {
+						},
+						"element_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"has_credentials": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"credentials_env_vars": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Elem: &schema.Resource{
@@ -174,7 +218,16 @@ func resourceAppdataDsource() *schema.Resource {
 						"shell": {
 							Type:     schema.TypeString,
 							Optional: true,
-						}, "credentials_env_vars": {
+						},
+						"element_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"has_credentials": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"credentials_env_vars": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Elem: &schema.Resource{
@@ -232,6 +285,7 @@ func resourceAppdataDsource() *schema.Resource {
 			"excludes": {
 				Type:     schema.TypeList,
 				Optional: true,
+				ForceNew: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -239,23 +293,59 @@ func resourceAppdataDsource() *schema.Resource {
 			"follow_symlinks": {
 				Type:     schema.TypeList,
 				Optional: true,
+				ForceNew: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"parameters": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: jsonSemanticDiffSuppress,
 			},
 			"sync_parameters": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: jsonSemanticDiffSuppress,
+			},
+			"attach_source_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"upgrade_repository_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"upgrade_environment_user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sync_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sync_trigger_parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: jsonSemanticDiffSuppress,
 			},
 			// Output
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_sync_snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"source_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -302,6 +392,12 @@ func resourceAppdataDsource() *schema.Resource {
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_detached": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"engine_id": {
@@ -333,12 +429,16 @@ func resourceAppdataDsource() *schema.Resource {
 				Default:  0,
 				Optional: true,
 			},
+			"snapshot_wait_failure_action": snapshotWaitFailureActionSchema(),
 			"skip_wait_for_snapshot_creation": {
 				Type:     schema.TypeBool,
 				Default:  false,
 				Optional: true,
 			},
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 	}
 }
 
@@ -436,8 +536,8 @@ func resourceAppdataDsourceCreate(ctx context.Context, d *schema.ResourceData, m
 	if v, has_v := d.GetOk("environment_user"); has_v {
 		appDataDSourceLinkSourceParameters.SetEnvironmentUser(v.(string))
 	}
-	if v, has_v := d.GetOk("tags"); has_v {
-		appDataDSourceLinkSourceParameters.SetTags(toTagArray(v))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		appDataDSourceLinkSourceParameters.SetTags(tags)
 	}
 	if v, has_v := d.GetOk("ops_pre_sync"); has_v {
 		appDataDSourceLinkSourceParameters.SetOpsPreSync(toSourceOperationArray(v))
@@ -471,47 +571,22 @@ func resourceAppdataDsourceCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(apiRes.GetDsourceId())
 
-	job_res, job_err := PollJobStatus(apiRes.Job.GetId(), ctx, client)
-	if job_err != "" {
-		tflog.Error(ctx, DLPX+ERROR+"Job Polling failed but continuing with dSource creation. Error: "+job_err)
+	snapshotDiags := linkDsourceAndWait(ctx, d, meta, apiRes.Job, resourceDsourceRead, true)
+	if snapshotDiags.HasError() {
+		return snapshotDiags
 	}
 
-	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_res)
-
-	rollback_on_failure := d.Get("rollback_on_failure").(bool)
-
-	if job_res == Failed || job_res == Canceled || job_res == Abandoned {
-		tflog.Error(ctx, DLPX+ERROR+"Job "+job_res+" "+apiRes.Job.GetId()+"!")
-		if rollback_on_failure {
-			if job_res == Failed {
-				res := isSnapSyncFailure(apiRes.Job.GetId(), ctx, client)
-				if res {
-					deleteDiags := resourceDsourceDelete(ctx, d, meta)
-					if deleteDiags.HasError() {
-						return deleteDiags
-					}
-					d.SetId("")
-				}
-			}
-		} else {
-			readDiags := resourceDsourceRead(ctx, d, meta)
-
-			if readDiags.HasError() {
-				return readDiags
-			}
-		}
-		return diag.Errorf("[NOT OK] Job %s %s with error %s", apiRes.Job.GetId(), job_res, job_err)
+	if diags := applyDsourceLifecycleOnCreate(ctx, d, client); diags != nil {
+		return diags
 	}
 
-	PollSnapshotStatus(d, ctx, client)
-
 	readDiags := resourceDsourceRead(ctx, d, meta)
 
 	if readDiags.HasError() {
 		return readDiags
 	}
 
-	return diags
+	return append(diags, snapshotDiags...)
 }
 
 func resourceDsourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -556,6 +631,12 @@ func resourceDsourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("rollback_on_failure", false)
 	}
 
+	ops_pre_sync_Raw, _ := d.Get("ops_pre_sync").([]interface{})
+	oldOpsPreSync := toSourceOperationArray(ops_pre_sync_Raw)
+
+	ops_post_sync_Raw, _ := d.Get("ops_post_sync").([]interface{})
+	oldOpsPostSync := toSourceOperationArray(ops_post_sync_Raw)
+
 	d.Set("id", result.GetId())
 	d.Set("database_type", result.GetDatabaseType())
 	d.Set("name", result.GetName())
@@ -564,31 +645,250 @@ func resourceDsourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("creation_date", result.GetCreationDate().String())
 	d.Set("group_name", result.GetGroupName())
 	d.Set("enabled", result.GetEnabled())
+	d.Set("is_detached", result.GetIsDetached())
 	d.Set("engine_id", result.GetEngineId())
 	d.Set("source_id", result.GetSourceId())
 	d.Set("status", result.GetStatus())
 	d.Set("engine_name", result.GetEngineName())
 	d.Set("current_timeflow_id", result.GetCurrentTimeflowId())
 	d.Set("is_appdata", result.GetIsAppdata())
+	d.Set("description", result.GetDescription())
+	d.Set("log_sync_enabled", result.GetLogsyncEnabled())
+	d.Set("tags", flattenResourceTags(d, meta, result.GetTags()))
+	d.Set("tags_all", flattenTags(result.GetTags()))
+	d.Set("ops_pre_sync", flattenDSourceHooks(result.GetHooks().OpsPreSync, oldOpsPreSync))
+	d.Set("ops_post_sync", flattenDSourceHooks(result.GetHooks().OpsPostSync, oldOpsPostSync))
+
+	return readAppdataDsourceLinkParameters(ctx, d, client, result)
+}
 
-	return diags
+// readAppdataDsourceLinkParameters sets the staging environment and the link parameters of an
+// AppData dSource, so that imports are complete and changes made outside Terraform show up in plans.
+func readAppdataDsourceLinkParameters(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient, dsource *dctapi.DSource) diag.Diagnostics {
+	if stagingSourceId := dsource.GetStagingSourceId(); stagingSourceId != "" {
+		stagingSource, httpRes, err := client.SourcesAPI.GetSourceById(ctx, stagingSourceId).Execute()
+		if diags := apiErrorResponseHelper(ctx, stagingSource, httpRes, err); diags != nil {
+			return diags
+		}
+		// staging_environment accepts either the environment id or its name, keep the configured form.
+		stagingEnvironment := d.Get("staging_environment").(string)
+		if stagingEnvironment != stagingSource.GetEnvironmentId() && stagingEnvironment != stagingSource.GetEnvironmentName() {
+			d.Set("staging_environment", stagingSource.GetEnvironmentId())
+		}
+	}
+
+	linkParams, httpRes, err := client.DSourcesAPI.GetAppdataDsourceLinkParameters(ctx, dsource.GetId()).Execute()
+	if diags := apiErrorResponseHelper(ctx, linkParams, httpRes, err); diags != nil {
+		return diags
+	}
+	if linkParams.HasParameters() {
+		parameters, err := flattenJsonParameters(linkParams.GetParameters(), d.Get("parameters").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("parameters", parameters)
+	}
+	d.Set("excludes", linkParams.GetExcludes())
+	d.Set("follow_symlinks", linkParams.GetFollowSymlinks())
+
+	return nil
 }
 
 func resourceDsourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+	updateAppdataDsource := dctapi.NewUpdateAppDataDSourceParameters()
+
+	dsourceId := d.Get("id").(string)
+
 	// get the changed keys
 	changedKeys := make([]string, 0, len(d.State().Attributes))
 	for k := range d.State().Attributes {
+		if strings.Contains(k, "tags") { // this is because the changed keys are of the form tag.0.keydi
+			k = "tags"
+		}
+		if strings.Contains(k, "ops_pre_sync") {
+			k = "ops_pre_sync"
+		}
+		if strings.Contains(k, "ops_post_sync") {
+			k = "ops_post_sync"
+		}
 		if d.HasChange(k) {
+			tflog.Debug(ctx, "changed keys"+k)
 			changedKeys = append(changedKeys, k)
 		}
 	}
-	// revert and set the old value to the changed keys
+
+	var nonUpdatableField []string
+
+	// check if the changed keys are updatable
 	for _, key := range changedKeys {
-		old, _ := d.GetChange(key)
-		d.Set(key, old)
+		if !updatableAppdataDsourceKeys[key] {
+			tflog.Debug(ctx, "non updatable field: "+key)
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+
+	// if not updatable keys are provided, error out
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	// set changed params in the updateAppdataDsource
+	if d.HasChange("name") {
+		updateAppdataDsource.SetName(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateAppdataDsource.SetDescription(d.Get("description").(string))
+	}
+	if d.HasChange("staging_environment") {
+		updateAppdataDsource.SetStagingEnvironment(d.Get("staging_environment").(string))
+	}
+	if d.HasChange("staging_environment_user") {
+		updateAppdataDsource.SetStagingEnvironmentUser(d.Get("staging_environment_user").(string))
+	}
+	if d.HasChange("parameters") {
+		params := make(map[string]interface{})
+		json.Unmarshal([]byte(d.Get("parameters").(string)), &params)
+		updateAppdataDsource.SetParameters(params)
+	}
+	if d.HasChange("sync_parameters") {
+		sync_params := make(map[string]interface{})
+		json.Unmarshal([]byte(d.Get("sync_parameters").(string)), &sync_params)
+		updateAppdataDsource.SetSyncParameters(sync_params)
+	}
+
+	// update hooks
+	if d.HasChanges("ops_pre_sync", "ops_post_sync") {
+		ndsh := dctapi.NewDSourceHooks()
+
+		if d.HasChange("ops_pre_sync") {
+			if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+				ndsh.SetOpsPreSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPreSync([]dctapi.Hook{})
+			}
+		}
+
+		if d.HasChange("ops_post_sync") {
+			if v, has_v := d.GetOk("ops_post_sync"); has_v {
+				ndsh.SetOpsPostSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPostSync([]dctapi.Hook{})
+			}
+		}
+
+		updateAppdataDsource.SetHooks(*ndsh)
+	}
+
+	if hasDsourceParameterChanges(changedKeys) {
+		res, httpRes, err := client.DSourcesAPI.UpdateAppdataDsourceById(ctx, dsourceId).UpdateAppDataDSourceParameters(*updateAppdataDsource).Execute()
+
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			// revert and set the old value to the changed keys
+			revertChanges(d, changedKeys)
+			return diags
+		}
+
+		job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
+		if job_err != "" {
+			tflog.Warn(ctx, DLPX+WARN+"Dsource Update Job Polling failed but continuing with update. Error: "+job_err)
+		}
+		tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+		if isJobTerminalFailure(job_status) {
+			return diag.Errorf("[NOT OK] Dsource-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectDsource); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	if d.HasChange("upgrade_repository_id") {
+		if diags := upgradeAppdataDsource(ctx, d, client); diags != nil {
+			revertChanges(d, []string{"upgrade_repository_id", "upgrade_environment_user_id"})
+			return diags
+		}
+	}
+
+	if d.HasChanges("enabled", "is_detached", "attach_source_id") {
+		attach := func(sourceId string) diag.Diagnostics {
+			return attachAppdataDsource(ctx, d, client, sourceId)
+		}
+		if diags := updateDsourceLifecycle(ctx, d, client, attach); diags != nil {
+			revertChanges(d, []string{"enabled", "is_detached", "attach_source_id"})
+			return diags
+		}
+	}
+
+	if d.HasChange("sync_trigger") {
+		snapshotParams := dctapi.NewDSourceSnapshotParameters()
+		if v, has_v := d.GetOk("sync_trigger_parameters"); has_v {
+			sync_params := make(map[string]interface{})
+			json.Unmarshal([]byte(v.(string)), &sync_params)
+			snapshotParams.SetAppdataParameters(sync_params)
+		}
+		if diags := syncDsource(ctx, client, dsourceId, snapshotParams); diags != nil {
+			old, _ := d.GetChange("sync_trigger")
+			d.Set("sync_trigger", old)
+			revertChanges(d, []string{"last_sync_snapshot_id", "last_sync_snapshot_timestamp", "last_sync_snapshot_timeflow_id"})
+			return diags
+		}
+		if diags := setLatestDsourceSnapshot(ctx, d, client); diags != nil {
+			return diags
+		}
 	}
 
-	return diag.Errorf("Action update not implemented for resource : dSource")
+	return diags
+}
+
+// upgradeAppdataDsource points the AppData dSource at the repository in upgrade_repository_id.
+func upgradeAppdataDsource(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient) diag.Diagnostics {
+	repositoryId := d.Get("upgrade_repository_id").(string)
+	if repositoryId == "" {
+		tflog.Info(ctx, DLPX+INFO+"upgrade_repository_id was removed, the dSource keeps its current repository.")
+		return nil
+	}
+	tflog.Info(ctx, DLPX+INFO+"Upgrade dSource "+d.Id()+" to repository "+repositoryId)
+	upgradeParams := dctapi.NewUpgradeAppDataDsourceParameters(repositoryId)
+	if v, has_v := d.GetOk("upgrade_environment_user_id"); has_v {
+		upgradeParams.SetEnvironmentUserId(v.(string))
+	}
+	apiRes, httpRes, err := client.DSourcesAPI.UpgradeAppdataDsource(ctx, d.Id()).UpgradeAppDataDsourceParameters(*upgradeParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource upgrade")
+}
+
+// attachAppdataDsource attaches a detached AppData dSource to the given source, reusing the link parameters.
+func attachAppdataDsource(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient, sourceId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Attach dSource "+d.Id()+" to source "+sourceId)
+	attachParams := dctapi.NewAppDataAttachDSourceParameters(sourceId)
+	if v, has_v := d.GetOk("staging_environment"); has_v {
+		attachParams.SetStagingEnvironment(v.(string))
+	}
+	if v, has_v := d.GetOk("staging_environment_user"); has_v {
+		attachParams.SetStagingEnvironmentUser(v.(string))
+	}
+	if v, has_v := d.GetOk("environment_user"); has_v {
+		attachParams.SetEnvironmentUser(v.(string))
+	}
+	if v, has_v := d.GetOk("parameters"); has_v {
+		params := make(map[string]interface{})
+		json.Unmarshal([]byte(v.(string)), &params)
+		attachParams.SetParameters(params)
+	}
+	apiRes, httpRes, err := client.DSourcesAPI.AttachAppdataDsource(ctx, d.Id()).AppDataAttachDSourceParameters(*attachParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource attach")
 }
 
 func resourceDsourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -596,8 +896,35 @@ func resourceDsourceDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	dsourceId := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("dSource %s has deletion_protection enabled. Set deletion_protection to false and apply before destroying it.", dsourceId)
+	}
+
+	vdbs, snapshots, diags := getDsourceDependents(ctx, client, dsourceId)
+	if diags != nil {
+		return diags
+	}
+
+	deleteDependents := d.Get("delete_dependents").(bool)
+	if len(vdbs) != 0 {
+		if !deleteDependents {
+			return diag.Errorf("dSource %s cannot be deleted as it has dependents. %s Delete them first or set delete_dependents to true.", dsourceId, describeDsourceDependents(vdbs, snapshots))
+		}
+		tflog.Info(ctx, DLPX+INFO+"Deleting dependents of dSource "+dsourceId+". "+describeDsourceDependents(vdbs, snapshots))
+		for _, vdb := range vdbs {
+			if diags := deleteVdbWithChildren(ctx, client, vdb.GetId()); diags != nil {
+				return diags
+			}
+		}
+	}
+
+	return deleteDsource(ctx, client, dsourceId, deleteDependents)
+}
+
+// deleteDsource deletes the dSource and waits until it no longer exists.
+func deleteDsource(ctx context.Context, client *dctapi.APIClient, dsourceId string, force bool) diag.Diagnostics {
 	deleteDsourceParams := dctapi.NewDeleteDSourceRequest(dsourceId)
-	deleteDsourceParams.SetForce(false)
+	deleteDsourceParams.SetForce(force)
 
 	res, httpRes, err := client.DSourcesAPI.DeleteDsource(ctx).DeleteDSourceRequest(*deleteDsourceParams).Execute()
 
@@ -38,10 +38,20 @@ func TestDsource_create_positive(t *testing.T) {
 			},
 			{
 				Config: testDsourceUpdate(sourceId, groupId, "update_same_dsource", environmentUser, stagingEnvironment, parameters),
-				Check:  resource.ComposeAggregateTestCheckFunc(
-				// irrelevant
-				),
-				ExpectError: regexp.MustCompile(`.*`),
+				Check: resource.ComposeTestCheckFunc(
+					testDsourceExists("delphix_appdata_dsource.new_data_dsource", sourceId),
+					resource.TestCheckResourceAttr("delphix_appdata_dsource.new_data_dsource", "name", "update_same_dsource")),
+			},
+			{
+				ResourceName:      "delphix_appdata_dsource.new_data_dsource",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"source_value", "group_id", "make_current_account_owner", "link_type", "staging_mount_base",
+					"staging_environment_user", "environment_user", "sync_parameters", "rollback_on_failure",
+					"rollback_on_failure_phases", "snapshot_wait_failure_action", "wait_time", "skip_wait_for_snapshot_creation",
+					"delete_dependents", "deletion_protection",
+				},
 			},
 		},
 	})
@@ -0,0 +1,674 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	AseNewBackup      string = "new_backup"
+	AseLatestBackup   string = "latest_backup"
+	AseSpecificBackup string = "specific_backup"
+)
+
+func resourceAseDsource() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource for SAP ASE dSource creation.",
+
+		CreateContext: resourceAseDsourceCreate,
+		ReadContext:   resourceAseDsourceRead,
+		UpdateContext: resourceAseDsourceUpdate,
+		DeleteContext: resourceDsourceDelete,
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rollback_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rollback_on_failure_phases": rollbackOnFailurePhasesSchema(),
+			"delete_dependents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source_value": {
+				Type:     schema.TypeString,
+				Required: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if old != new {
+						tflog.Info(context.Background(), "updating source_value is not allowed. plan changes are suppressed")
+					}
+					return d.Id() != ""
+				},
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if old != new {
+						tflog.Info(context.Background(), "updating group_id is not allowed. plan changes are suppressed")
+					}
+					return d.Id() != ""
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_sync_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"make_current_account_owner": createOnlySchema(schema.TypeBool),
+			"staging_repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"staging_host_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_host_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_file_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mount_base": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"load_backup_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backup_server_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backup_host": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backup_host_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dump_history_file_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"drop_and_recreate_devices": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"sync_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      AseNewBackup,
+				ValidateFunc: validation.StringInSlice([]string{AseNewBackup, AseLatestBackup, AseSpecificBackup}, false),
+			},
+			"ase_backup_files": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"validated_sync_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED"}, false),
+			},
+			"dump_credentials": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"db_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"db_password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: conflictingCredentialKeys("db_", "password"),
+			},
+			"db_hashicorp_vault_engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "hashicorp"),
+			},
+			"db_hashicorp_vault_secret_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "hashicorp"),
+			},
+			"db_hashicorp_vault_username_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "hashicorp"),
+			},
+			"db_hashicorp_vault_secret_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "hashicorp"),
+			},
+			"db_azure_vault_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "azure"),
+			},
+			"db_azure_vault_username_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "azure"),
+			},
+			"db_azure_vault_secret_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "azure"),
+			},
+			"db_cyberark_vault_query_string": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("db_", "cyberark"),
+			},
+			"tags":                dsourceTagsSchema(),
+			"tags_all":            tagsAllSchema(),
+			"ops_pre_sync":        dsourceOperationsSchema(),
+			"ops_post_sync":       dsourceOperationsSchema(),
+			"pre_validated_sync":  dsourceOperationsSchema(),
+			"post_validated_sync": dsourceOperationsSchema(),
+			// Output
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_detached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_time": {
+				Type:     schema.TypeInt,
+				Default:  0,
+				Optional: true,
+			},
+			"snapshot_wait_failure_action": snapshotWaitFailureActionSchema(),
+			"skip_wait_for_snapshot_creation": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceAseDsourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+
+	syncStrategy := d.Get("sync_strategy").(string)
+	if _, has_v := d.GetOk("ase_backup_files"); has_v && syncStrategy != AseSpecificBackup {
+		return diag.Errorf("ase_backup_files is only supported for sync_strategy = '%s'", AseSpecificBackup)
+	}
+
+	aseDSourceLinkSourceParameters := dctapi.NewASEDSourceLinkSourceParameters(d.Get("source_value").(string), d.Get("staging_repository").(string))
+
+	aseDSourceLinkSourceParameters.SetSyncStrategy(syncStrategy)
+	if v, has_v := d.GetOk("name"); has_v {
+		aseDSourceLinkSourceParameters.SetName(v.(string))
+	}
+	if v, has_v := d.GetOk("group_id"); has_v {
+		aseDSourceLinkSourceParameters.SetGroupId(v.(string))
+	}
+	if v, has_v := d.GetOk("description"); has_v {
+		aseDSourceLinkSourceParameters.SetDescription(v.(string))
+	}
+	if v, has_v := d.GetOkExists("log_sync_enabled"); has_v {
+		aseDSourceLinkSourceParameters.SetLogSyncEnabled(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("make_current_account_owner"); has_v {
+		aseDSourceLinkSourceParameters.SetMakeCurrentAccountOwner(v.(bool))
+	}
+	if v, has_v := d.GetOk("staging_host_user"); has_v {
+		aseDSourceLinkSourceParameters.SetStagingHostUser(v.(string))
+	}
+	if v, has_v := d.GetOk("source_host_user"); has_v {
+		aseDSourceLinkSourceParameters.SetSourceHostUser(v.(string))
+	}
+	if v, has_v := d.GetOk("external_file_path"); has_v {
+		aseDSourceLinkSourceParameters.SetExternalFilePath(v.(string))
+	}
+	if v, has_v := d.GetOk("mount_base"); has_v {
+		aseDSourceLinkSourceParameters.SetMountBase(v.(string))
+	}
+	if v, has_v := d.GetOk("load_backup_path"); has_v {
+		aseDSourceLinkSourceParameters.SetLoadBackupPath(v.(string))
+	}
+	if v, has_v := d.GetOk("backup_server_name"); has_v {
+		aseDSourceLinkSourceParameters.SetBackupServerName(v.(string))
+	}
+	if v, has_v := d.GetOk("backup_host"); has_v {
+		aseDSourceLinkSourceParameters.SetBackupHost(v.(string))
+	}
+	if v, has_v := d.GetOk("backup_host_user"); has_v {
+		aseDSourceLinkSourceParameters.SetBackupHostUser(v.(string))
+	}
+	if v, has_v := d.GetOkExists("dump_history_file_enabled"); has_v {
+		aseDSourceLinkSourceParameters.SetDumpHistoryFileEnabled(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("drop_and_recreate_devices"); has_v {
+		aseDSourceLinkSourceParameters.SetDropAndRecreateDevices(v.(bool))
+	}
+	if v, has_v := d.GetOk("ase_backup_files"); has_v {
+		aseDSourceLinkSourceParameters.SetAseBackupFiles(toStringArray(v))
+	}
+	if v, has_v := d.GetOk("validated_sync_mode"); has_v {
+		aseDSourceLinkSourceParameters.SetValidatedSyncMode(v.(string))
+	}
+	if v, has_v := d.GetOk("dump_credentials"); has_v {
+		aseDSourceLinkSourceParameters.SetDumpCredentials(v.(string))
+	}
+	if v, has_v := d.GetOk("db_user"); has_v {
+		aseDSourceLinkSourceParameters.SetDbUser(v.(string))
+	}
+	if v, has_v := d.GetOk("db_password"); has_v {
+		aseDSourceLinkSourceParameters.SetDbPassword(v.(string))
+	}
+	if v, has_v := d.GetOk("db_hashicorp_vault_engine"); has_v {
+		aseDSourceLinkSourceParameters.SetDbHashicorpVaultEngine(v.(string))
+	}
+	if v, has_v := d.GetOk("db_hashicorp_vault_secret_path"); has_v {
+		aseDSourceLinkSourceParameters.SetDbHashicorpVaultSecretPath(v.(string))
+	}
+	if v, has_v := d.GetOk("db_hashicorp_vault_username_key"); has_v {
+		aseDSourceLinkSourceParameters.SetDbHashicorpVaultUsernameKey(v.(string))
+	}
+	if v, has_v := d.GetOk("db_hashicorp_vault_secret_key"); has_v {
+		aseDSourceLinkSourceParameters.SetDbHashicorpVaultSecretKey(v.(string))
+	}
+	if v, has_v := d.GetOk("db_azure_vault_name"); has_v {
+		aseDSourceLinkSourceParameters.SetDbAzureVaultName(v.(string))
+	}
+	if v, has_v := d.GetOk("db_azure_vault_username_key"); has_v {
+		aseDSourceLinkSourceParameters.SetDbAzureVaultUsernameKey(v.(string))
+	}
+	if v, has_v := d.GetOk("db_azure_vault_secret_key"); has_v {
+		aseDSourceLinkSourceParameters.SetDbAzureVaultSecretKey(v.(string))
+	}
+	if v, has_v := d.GetOk("db_cyberark_vault_query_string"); has_v {
+		aseDSourceLinkSourceParameters.SetDbCyberarkVaultQueryString(v.(string))
+	}
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		aseDSourceLinkSourceParameters.SetTags(tags)
+	}
+	if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+		aseDSourceLinkSourceParameters.SetOpsPreSync(toSourceOperationArray(v))
+	}
+	if v, has_v := d.GetOk("ops_post_sync"); has_v {
+		aseDSourceLinkSourceParameters.SetOpsPostSync(toSourceOperationArray(v))
+	}
+	if v, has_v := d.GetOk("pre_validated_sync"); has_v {
+		aseDSourceLinkSourceParameters.SetPreValidatedSync(toSourceOperationArray(v))
+	}
+	if v, has_v := d.GetOk("post_validated_sync"); has_v {
+		aseDSourceLinkSourceParameters.SetPostValidatedSync(toSourceOperationArray(v))
+	}
+
+	apiRes, httpRes, err := client.DSourcesAPI.LinkAseDatabase(ctx).ASEDSourceLinkSourceParameters(*aseDSourceLinkSourceParameters).Execute()
+
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	d.SetId(apiRes.GetDsourceId())
+
+	snapshotDiags := linkDsourceAndWait(ctx, d, meta, apiRes.Job, resourceAseDsourceRead, true)
+	if snapshotDiags.HasError() {
+		return snapshotDiags
+	}
+
+	readDiags := resourceAseDsourceRead(ctx, d, meta)
+
+	if readDiags.HasError() {
+		return readDiags
+	}
+
+	return append(diags, snapshotDiags...)
+}
+
+func resourceAseDsourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	dsource_id := d.Id()
+
+	res, diags := PollForObjectExistence(ctx, func() (interface{}, *http.Response, error) {
+		return client.DSourcesAPI.GetDsourceById(ctx, dsource_id).Execute()
+	})
+
+	if res == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Dsource not found: "+dsource_id+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	if diags != nil {
+		_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+			return client.DSourcesAPI.GetDsourceById(ctx, dsource_id).Execute()
+		})
+		// This would imply error in poll for deletion so we just log and exit.
+		if diags != nil {
+			tflog.Error(ctx, DLPX+ERROR+"Error in polling of dSource for deletion.")
+		} else {
+			// diags will be nil in case of successful poll for deletion logic aka 404
+			tflog.Error(ctx, DLPX+ERROR+"Error reading the dSource "+dsource_id+", removing from state.")
+			d.SetId("")
+		}
+
+		return nil
+	}
+
+	result, ok := res.(*dctapi.DSource)
+	if !ok {
+		return diag.Errorf("Error occured in type casting.")
+	}
+
+	_, rollback_on_failure_exists := d.GetOk("rollback_on_failure")
+	if !rollback_on_failure_exists {
+		// its an import or upgrade, set to default value
+		d.Set("rollback_on_failure", false)
+	}
+
+	ops_pre_sync_Raw, _ := d.Get("ops_pre_sync").([]interface{})
+	oldOpsPreSync := toSourceOperationArray(ops_pre_sync_Raw)
+
+	ops_post_sync_Raw, _ := d.Get("ops_post_sync").([]interface{})
+	oldOpsPostSync := toSourceOperationArray(ops_post_sync_Raw)
+
+	d.Set("id", result.GetId())
+	d.Set("database_type", result.GetDatabaseType())
+	d.Set("name", result.GetName())
+	d.Set("description", result.GetDescription())
+	d.Set("database_version", result.GetDatabaseVersion())
+	d.Set("data_uuid", result.GetDataUuid())
+	d.Set("creation_date", result.GetCreationDate().String())
+	d.Set("group_name", result.GetGroupName())
+	d.Set("enabled", result.GetEnabled())
+	d.Set("is_detached", result.GetIsDetached())
+	d.Set("engine_id", result.GetEngineId())
+	d.Set("source_id", result.GetSourceId())
+	d.Set("status", result.GetStatus())
+	d.Set("engine_name", result.GetEngineName())
+	d.Set("current_timeflow_id", result.GetCurrentTimeflowId())
+	d.Set("log_sync_enabled", result.GetLogsyncEnabled())
+	d.Set("tags", flattenResourceTags(d, meta, result.GetTags()))
+	d.Set("tags_all", flattenTags(result.GetTags()))
+	d.Set("ops_pre_sync", flattenDSourceHooks(result.GetHooks().OpsPreSync, oldOpsPreSync))
+	d.Set("ops_post_sync", flattenDSourceHooks(result.GetHooks().OpsPostSync, oldOpsPostSync))
+	return diags
+}
+
+func resourceAseDsourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+	updateAseDsource := dctapi.NewUpdateASEDsourceParameters()
+
+	dsourceId := d.Get("id").(string)
+
+	// get the changed keys
+	changedKeys := make([]string, 0, len(d.State().Attributes))
+	for k := range d.State().Attributes {
+		if strings.Contains(k, "tags") { // this is because the changed keys are of the form tag.0.keydi
+			k = "tags"
+		}
+		if strings.Contains(k, "ops_pre_sync") {
+			k = "ops_pre_sync"
+		}
+		if strings.Contains(k, "ops_post_sync") {
+			k = "ops_post_sync"
+		}
+		if strings.Contains(k, "pre_validated_sync") {
+			k = "pre_validated_sync"
+		}
+		if strings.Contains(k, "post_validated_sync") {
+			k = "post_validated_sync"
+		}
+		if strings.Contains(k, "ase_backup_files") {
+			k = "ase_backup_files"
+		}
+		if d.HasChange(k) {
+			tflog.Debug(ctx, "changed keys"+k)
+			changedKeys = append(changedKeys, k)
+		}
+	}
+
+	var nonUpdatableField []string
+
+	// check if the changed keys are updatable
+	for _, key := range changedKeys {
+		if !updatableAseDsourceKeys[key] {
+			tflog.Debug(ctx, "non updatable field: "+key)
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+
+	// if not updatable keys are provided, error out
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	// set changed params in the updateAseDsource
+	if d.HasChange("name") {
+		updateAseDsource.SetName(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateAseDsource.SetDescription(d.Get("description").(string))
+	}
+	if d.HasChange("staging_host_user") {
+		updateAseDsource.SetStagingHostUser(d.Get("staging_host_user").(string))
+	}
+	if d.HasChange("source_host_user") {
+		updateAseDsource.SetSourceHostUser(d.Get("source_host_user").(string))
+	}
+	if d.HasChange("external_file_path") {
+		updateAseDsource.SetExternalFilePath(d.Get("external_file_path").(string))
+	}
+	if d.HasChange("load_backup_path") {
+		updateAseDsource.SetLoadBackupPath(d.Get("load_backup_path").(string))
+	}
+	if d.HasChange("backup_server_name") {
+		updateAseDsource.SetBackupServerName(d.Get("backup_server_name").(string))
+	}
+	if d.HasChange("backup_host") {
+		updateAseDsource.SetBackupHost(d.Get("backup_host").(string))
+	}
+	if d.HasChange("backup_host_user") {
+		updateAseDsource.SetBackupHostUser(d.Get("backup_host_user").(string))
+	}
+	if d.HasChange("dump_history_file_enabled") {
+		updateAseDsource.SetDumpHistoryFileEnabled(d.Get("dump_history_file_enabled").(bool))
+	}
+	if d.HasChange("drop_and_recreate_devices") {
+		updateAseDsource.SetDropAndRecreateDevices(d.Get("drop_and_recreate_devices").(bool))
+	}
+	if d.HasChange("validated_sync_mode") {
+		updateAseDsource.SetValidatedSyncMode(d.Get("validated_sync_mode").(string))
+	}
+	if d.HasChange("dump_credentials") {
+		updateAseDsource.SetDumpCredentials(d.Get("dump_credentials").(string))
+	}
+	if d.HasChange("db_user") {
+		updateAseDsource.SetDbUser(d.Get("db_user").(string))
+	}
+	if d.HasChange("db_password") {
+		updateAseDsource.SetDbPassword(d.Get("db_password").(string))
+	}
+	if d.HasChange("db_hashicorp_vault_engine") {
+		updateAseDsource.SetDbHashicorpVaultEngine(d.Get("db_hashicorp_vault_engine").(string))
+	}
+	if d.HasChange("db_hashicorp_vault_secret_path") {
+		updateAseDsource.SetDbHashicorpVaultSecretPath(d.Get("db_hashicorp_vault_secret_path").(string))
+	}
+	if d.HasChange("db_hashicorp_vault_username_key") {
+		updateAseDsource.SetDbHashicorpVaultUsernameKey(d.Get("db_hashicorp_vault_username_key").(string))
+	}
+	if d.HasChange("db_hashicorp_vault_secret_key") {
+		updateAseDsource.SetDbHashicorpVaultSecretKey(d.Get("db_hashicorp_vault_secret_key").(string))
+	}
+	if d.HasChange("db_azure_vault_name") {
+		updateAseDsource.SetDbAzureVaultName(d.Get("db_azure_vault_name").(string))
+	}
+	if d.HasChange("db_azure_vault_username_key") {
+		updateAseDsource.SetDbAzureVaultUsernameKey(d.Get("db_azure_vault_username_key").(string))
+	}
+	if d.HasChange("db_azure_vault_secret_key") {
+		updateAseDsource.SetDbAzureVaultSecretKey(d.Get("db_azure_vault_secret_key").(string))
+	}
+	if d.HasChange("db_cyberark_vault_query_string") {
+		updateAseDsource.SetDbCyberarkVaultQueryString(d.Get("db_cyberark_vault_query_string").(string))
+	}
+	if d.HasChange("pre_validated_sync") {
+		updateAseDsource.SetPreValidatedSync(toSourceOperationArray(d.Get("pre_validated_sync")))
+	}
+	if d.HasChange("post_validated_sync") {
+		updateAseDsource.SetPostValidatedSync(toSourceOperationArray(d.Get("post_validated_sync")))
+	}
+
+	// update hooks
+	if d.HasChanges("ops_pre_sync", "ops_post_sync") {
+		ndsh := dctapi.NewDSourceHooks()
+
+		if d.HasChange("ops_pre_sync") {
+			if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+				ndsh.SetOpsPreSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPreSync([]dctapi.Hook{})
+			}
+		}
+
+		if d.HasChange("ops_post_sync") {
+			if v, has_v := d.GetOk("ops_post_sync"); has_v {
+				ndsh.SetOpsPostSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPostSync([]dctapi.Hook{})
+			}
+		}
+
+		updateAseDsource.SetHooks(*ndsh)
+	}
+
+	if hasDsourceParameterChanges(changedKeys) {
+		res, httpRes, err := client.DSourcesAPI.UpdateAseDsourceById(ctx, dsourceId).UpdateASEDsourceParameters(*updateAseDsource).Execute()
+
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			// revert and set the old value to the changed keys
+			revertChanges(d, changedKeys)
+			return diags
+		}
+
+		job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
+		if job_err != "" {
+			tflog.Warn(ctx, DLPX+WARN+"Dsource Update Job Polling failed but continuing with update. Error: "+job_err)
+		}
+		tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+		if isJobTerminalFailure(job_status) {
+			return diag.Errorf("[NOT OK] Dsource-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectDsource); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAseDsource_create_positive(t *testing.T) {
+	sourcevalue := os.Getenv("ASE_DSOURCE_SOURCE_VALUE")
+	groupId := os.Getenv("ASE_DSOURCE_GROUP_ID")
+	name := os.Getenv("ASE_DSOURCE_NAME")
+	stagingRepository := os.Getenv("ASE_DSOURCE_STAGING_REPOSITORY")
+	loadBackupPath := os.Getenv("ASE_DSOURCE_LOAD_BACKUP_PATH")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAseDsourcePreCheck(t, sourcevalue, groupId, name, stagingRepository, loadBackupPath)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAseDsourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAseDsourceBasic(name, sourcevalue, groupId, stagingRepository, loadBackupPath),
+				Check: resource.ComposeTestCheckFunc(
+					testOracleDsourceExists("delphix_ase_dsource.test_ase_dsource", sourcevalue),
+					resource.TestCheckResourceAttr("delphix_ase_dsource.test_ase_dsource", "source_id", sourcevalue)),
+			},
+			{
+				// positive update test case
+				Config: testAseDsourceBasic("update_name", sourcevalue, groupId, stagingRepository, loadBackupPath),
+				Check: resource.ComposeTestCheckFunc(
+					testOracleDsourceExists("delphix_ase_dsource.test_ase_dsource", sourcevalue),
+					resource.TestCheckResourceAttr("delphix_ase_dsource.test_ase_dsource", "name", "update_name")),
+			},
+			{
+				// negative update test case, the staging repository is not updatable
+				Config:      testAseDsourceBasic("update_name", sourcevalue, groupId, "non-existent", loadBackupPath),
+				ExpectError: regexp.MustCompile(`.*`),
+			},
+		},
+	})
+}
+
+func testAseDsourcePreCheck(t *testing.T, sourceId string, groupId string, name string, stagingRepository string, loadBackupPath string) {
+	testAccPreCheck(t)
+	if sourceId == "" {
+		t.Fatal("ASE_DSOURCE_SOURCE_VALUE must be set for env acceptance tests")
+	}
+	if groupId == "" {
+		t.Fatal("ASE_DSOURCE_GROUP_ID must be set for env acceptance tests")
+	}
+	if name == "" {
+		t.Fatal("ASE_DSOURCE_NAME must be set for env acceptance tests")
+	}
+	if stagingRepository == "" {
+		t.Fatal("ASE_DSOURCE_STAGING_REPOSITORY must be set for env acceptance tests")
+	}
+	if loadBackupPath == "" {
+		t.Fatal("ASE_DSOURCE_LOAD_BACKUP_PATH must be set for env acceptance tests")
+	}
+}
+
+func testAseDsourceBasic(name string, sourceValue string, groupId string, stagingRepository string, loadBackupPath string) string {
+	return fmt.Sprintf(`
+resource "delphix_ase_dsource" "test_ase_dsource" {
+  name               = "%s"
+  source_value       = "%s"
+  group_id           = "%s"
+  staging_repository = "%s"
+  load_backup_path   = "%s"
+}
+	`, name, sourceValue, groupId, stagingRepository, loadBackupPath)
+}
+
+func testAseDsourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "delphix_ase_dsource" {
+			continue
+		}
+
+		dsourceId := rs.Primary.ID
+
+		_, httpResp, _ := client.DSourcesAPI.GetDsourceById(context.Background(), dsourceId).Execute()
+		if httpResp == nil {
+			return fmt.Errorf("Dsource has not been deleted")
+		}
+
+		if httpResp.StatusCode != 404 {
+			return fmt.Errorf("Exepcted a 404 Not Found for a deleted Dsource but got %d", httpResp.StatusCode)
+		}
+	}
+
+	return nil
+}
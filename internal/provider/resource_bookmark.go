@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBookmark() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for managing bookmarks on VDBs and VDB groups.",
+
+		CreateContext: resourceBookmarkCreate,
+		ReadContext:   resourceBookmarkRead,
+		UpdateContext: resourceBookmarkUpdate,
+		DeleteContext: resourceBookmarkDelete,
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vdb_ids": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vdb_group_id"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"vdb_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vdb_ids"},
+			},
+			// the point in time of the bookmark isn't returned by DCT, so it is create-only and only
+			// replaces the bookmark when a value in the state changes.
+			"snapshot_ids": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressCreateOnlyDiff,
+				ConflictsWith:    []string{"timestamp", "timestamp_in_database_timezone"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timestamp": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressCreateOnlyDiff,
+				ConflictsWith:    []string{"snapshot_ids", "timestamp_in_database_timezone"},
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+			"timestamp_in_database_timezone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressCreateOnlyDiff,
+				ConflictsWith:    []string{"snapshot_ids", "timestamp"},
+			},
+			"expiration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"retain_forever"},
+			},
+			"retain_forever": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"expiration"},
+			},
+			"make_current_account_owner": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressCreateOnlyDiff,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags_all": tagsAllSchema(),
+			// Output
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vdb_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBookmarkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+
+	bookmarkCreateParams := dctapi.NewBookmarkCreateParameters(d.Get("name").(string))
+
+	if v, has_v := d.GetOk("vdb_ids"); has_v {
+		bookmarkCreateParams.SetVdbIds(toStringArray(v))
+	}
+	if v, has_v := d.GetOk("vdb_group_id"); has_v {
+		bookmarkCreateParams.SetVdbGroupId(v.(string))
+	}
+	if len(bookmarkCreateParams.GetVdbIds()) == 0 && bookmarkCreateParams.GetVdbGroupId() == "" {
+		return diag.Errorf("one of vdb_ids or vdb_group_id must be set to create a bookmark.")
+	}
+	if v, has_v := d.GetOk("snapshot_ids"); has_v {
+		bookmarkCreateParams.SetSnapshotIds(toStringArray(v))
+	}
+	if v, has_v := d.GetOk("timestamp"); has_v {
+		timestamp, _ := time.Parse(time.RFC3339, v.(string))
+		bookmarkCreateParams.SetTimestamp(timestamp)
+	}
+	if v, has_v := d.GetOk("timestamp_in_database_timezone"); has_v {
+		bookmarkCreateParams.SetTimestampInDatabaseTimezone(v.(string))
+	}
+	if v, has_v := d.GetOk("expiration"); has_v {
+		bookmarkCreateParams.SetExpiration(v.(string))
+	}
+	if v, has_v := d.GetOkExists("retain_forever"); has_v {
+		bookmarkCreateParams.SetRetainForever(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("make_current_account_owner"); has_v {
+		bookmarkCreateParams.SetMakeCurrentAccountOwner(v.(bool))
+	}
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		bookmarkCreateParams.SetTags(tags)
+	}
+
+	apiRes, httpRes, err := client.BookmarksAPI.CreateBookmark(ctx).BookmarkCreateParameters(*bookmarkCreateParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	d.SetId(apiRes.Bookmark.GetId())
+	tflog.Info(ctx, DLPX+INFO+"Bookmark create job: "+apiRes.Job.GetId())
+
+	if diags := waitForJob(ctx, client, apiRes.Job.GetId(), "Bookmark create"); diags != nil {
+		d.SetId("")
+		return diags
+	}
+
+	readDiags := resourceBookmarkRead(ctx, d, meta)
+	if readDiags.HasError() {
+		return readDiags
+	}
+	return diags
+}
+
+func resourceBookmarkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	bookmarkId := d.Id()
+
+	res, diags := PollForObjectExistence(ctx, func() (interface{}, *http.Response, error) {
+		return client.BookmarksAPI.GetBookmarkById(ctx, bookmarkId).Execute()
+	})
+
+	if res == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Bookmark not found: "+bookmarkId+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	if diags != nil {
+		_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+			return client.BookmarksAPI.GetBookmarkById(ctx, bookmarkId).Execute()
+		})
+		if diags != nil {
+			tflog.Error(ctx, DLPX+ERROR+"Error in polling of bookmark for deletion.")
+		} else {
+			tflog.Error(ctx, DLPX+ERROR+"Error reading the bookmark "+bookmarkId+", removing from state.")
+			d.SetId("")
+		}
+		return nil
+	}
+
+	bookmark, ok := res.(*dctapi.Bookmark)
+	if !ok {
+		return diag.Errorf("Error occured in type casting.")
+	}
+
+	d.Set("name", bookmark.GetName())
+	d.Set("vdb_ids", bookmark.GetVdbIds())
+	d.Set("vdb_group_id", bookmark.GetVdbGroupId())
+	d.Set("vdb_group_name", bookmark.GetVdbGroupName())
+	d.Set("expiration", bookmark.GetExpiration())
+	d.Set("retain_forever", bookmark.GetRetainForever())
+	d.Set("creation_date", bookmark.GetCreationDate().String())
+	d.Set("data_timestamp", bookmark.GetDataTimestamp().String())
+	d.Set("timeflow_id", bookmark.GetTimeflowId())
+	d.Set("location", bookmark.GetLocation())
+	d.Set("status", bookmark.GetBookmarkStatus())
+	d.Set("tags", flattenResourceTags(d, meta, bookmark.GetTags()))
+	d.Set("tags_all", flattenTags(bookmark.GetTags()))
+
+	return diags
+}
+
+func resourceBookmarkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	bookmarkId := d.Id()
+
+	// get the changed keys
+	changedKeys := make([]string, 0, len(d.State().Attributes))
+	for k := range d.State().Attributes {
+		if strings.Contains(k, "tags") { // this is because the changed keys are of the form tag.0.key
+			k = "tags"
+		}
+		if strings.Contains(k, "vdb_ids") {
+			k = "vdb_ids"
+		}
+		if strings.Contains(k, "snapshot_ids") {
+			k = "snapshot_ids"
+		}
+		if d.HasChange(k) {
+			tflog.Debug(ctx, "changed keys"+k)
+			changedKeys = append(changedKeys, k)
+		}
+	}
+
+	var nonUpdatableField []string
+
+	// check if the changed keys are updatable
+	for _, key := range changedKeys {
+		if !updatableBookmarkKeys[key] {
+			tflog.Debug(ctx, "non updatable field: "+key)
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+
+	// if not updatable keys are provided, error out
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	if d.HasChanges("name", "expiration", "retain_forever") {
+		bookmarkUpdateParams := dctapi.NewBookmarkUpdateParameters()
+		if d.HasChange("name") {
+			bookmarkUpdateParams.SetName(d.Get("name").(string))
+		}
+		if d.HasChange("expiration") {
+			bookmarkUpdateParams.SetExpiration(d.Get("expiration").(string))
+		}
+		if d.HasChange("retain_forever") {
+			bookmarkUpdateParams.SetRetainForever(d.Get("retain_forever").(bool))
+		}
+
+		apiRes, httpRes, err := client.BookmarksAPI.UpdateBookmark(ctx, bookmarkId).BookmarkUpdateParameters(*bookmarkUpdateParams).Execute()
+		if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+		if diags := waitForJob(ctx, client, apiRes.Job.GetId(), "Bookmark update"); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectBookmark); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	return resourceBookmarkRead(ctx, d, meta)
+}
+
+func resourceBookmarkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	bookmarkId := d.Id()
+
+	apiRes, httpRes, err := client.BookmarksAPI.DeleteBookmark(ctx, bookmarkId).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	if diags := waitForJob(ctx, client, apiRes.Job.GetId(), "Bookmark delete"); diags != nil {
+		return diags
+	}
+
+	_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+		return client.BookmarksAPI.GetBookmarkById(ctx, bookmarkId).Execute()
+	})
+
+	return diags
+}
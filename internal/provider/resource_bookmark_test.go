@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBookmark_create_positive(t *testing.T) {
+	datasource_id := os.Getenv("DATASOURCE_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccVdbPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBookmarkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDctBookmarkConfig(datasource_id, "tf-acc-bookmark", "2099-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDctBookmarkResourceExists("delphix_bookmark.new", "tf-acc-bookmark"),
+					resource.TestCheckResourceAttr("delphix_bookmark.new", "expiration", "2099-01-01")),
+			},
+			{
+				Config: testAccCheckDctBookmarkConfig(datasource_id, "tf-acc-bookmark-renamed", "2099-06-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDctBookmarkResourceExists("delphix_bookmark.new", "tf-acc-bookmark-renamed"),
+					resource.TestCheckResourceAttr("delphix_bookmark.new", "expiration", "2099-06-01")),
+			},
+		},
+	})
+}
+
+func testAccCheckDctBookmarkConfig(datasource_id string, name string, expiration string) string {
+	return fmt.Sprintf(`
+	resource "delphix_vdb" "new" {
+		auto_select_repository = true
+		source_data_id         = "%s"
+	}
+	resource "delphix_bookmark" "new" {
+		name       = "%s"
+		vdb_ids    = [delphix_vdb.new.id]
+		expiration = "%s"
+		tags {
+			key   = "team"
+			value = "qa"
+		}
+	}
+	`, datasource_id, name, expiration)
+}
+
+func testAccCheckDctBookmarkResourceExists(n string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bookmarkId := rs.Primary.ID
+		if bookmarkId == "" {
+			return fmt.Errorf("No BookmarkID set")
+		}
+
+		client := testAccProvider.Meta().(*apiClient).client
+		res, _, err := client.BookmarksAPI.GetBookmarkById(context.Background(), bookmarkId).Execute()
+		if err != nil {
+			return err
+		}
+
+		if res.GetName() != name {
+			return fmt.Errorf("Bookmark name mismatch, expected %s but got %s", name, res.GetName())
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBookmarkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "delphix_bookmark" {
+			continue
+		}
+
+		bookmarkId := rs.Primary.ID
+
+		_, httpResp, _ := client.BookmarksAPI.GetBookmarkById(context.Background(), bookmarkId).Execute()
+		if httpResp == nil {
+			return fmt.Errorf("Bookmark has not been deleted")
+		}
+
+		if httpResp.StatusCode != 404 {
+			return fmt.Errorf("Exepcted a 404 Not Found for a deleted Bookmark but got %d", httpResp.StatusCode)
+		}
+	}
+
+	return nil
+}
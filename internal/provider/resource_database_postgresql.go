@@ -20,6 +20,7 @@ func resourceSource() *schema.Resource {
 		ReadContext:   resourceDatabasePostgressqlRead,
 		UpdateContext: resourceDatabasePostgressqlUpdate,
 		DeleteContext: resourceDatabasePostgressqlDelete,
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -124,6 +125,7 @@ func resourceSource() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -149,6 +151,9 @@ func resourceDatabasePostgressqlCreate(ctx context.Context, d *schema.ResourceDa
 	if v, has_v := d.GetOk("engine_value"); has_v {
 		sourceCreateParameters.SetEngineId(v.(string))
 	}
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		sourceCreateParameters.SetTags(tags)
+	}
 
 	req := client.SourcesAPI.CreatePostgresSource(ctx)
 
@@ -253,6 +258,8 @@ func resourceDatabasePostgressqlRead(ctx context.Context, d *schema.ResourceData
 	d.Set("is_dsource", result.GetIsDsource())
 	d.Set("repository", result.GetRepository())
 	d.Set("appdata_source_type", result.GetAppdataSourceType())
+	d.Set("tags", flattenResourceTags(d, meta, result.GetTags()))
+	d.Set("tags_all", flattenTags(result.GetTags()))
 
 	return diags
 }
@@ -287,26 +294,36 @@ func resourceDatabasePostgressqlUpdate(ctx context.Context, d *schema.ResourceDa
 
 	if d.HasChange("name") {
 		updateSourceParam.SetName(d.Get("name").(string))
-	}
 
-	res, httpRes, err := client.SourcesAPI.UpdatePostgresSourceById(ctx, d.Get("id").(string)).PostgresSourceUpdateParameters(*updateSourceParam).Execute()
+		res, httpRes, err := client.SourcesAPI.UpdatePostgresSourceById(ctx, d.Get("id").(string)).PostgresSourceUpdateParameters(*updateSourceParam).Execute()
 
-	if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
-		// revert and set the old value to the changed keys
-		for _, key := range changedKeys {
-			old, _ := d.GetChange(key)
-			d.Set(key, old)
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			// revert and set the old value to the changed keys
+			for _, key := range changedKeys {
+				old, _ := d.GetChange(key)
+				d.Set(key, old)
+			}
+			return diags
 		}
-		return diags
-	}
 
-	job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
-	if job_err != "" {
-		tflog.Warn(ctx, DLPX+WARN+"Source Update Job Polling failed but continuing with update. Error :"+job_err)
+		job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
+		if job_err != "" {
+			tflog.Warn(ctx, DLPX+WARN+"Source Update Job Polling failed but continuing with update. Error :"+job_err)
+		}
+		tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+		if isJobTerminalFailure(job_status) {
+			return diag.Errorf("[NOT OK] Source-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
+		}
 	}
-	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
-	if isJobTerminalFailure(job_status) {
-		return diag.Errorf("[NOT OK] Source-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectSource); diags != nil {
+			for _, key := range changedKeys {
+				old, _ := d.GetChange(key)
+				d.Set(key, old)
+			}
+			return diags
+		}
 	}
 
 	return diags
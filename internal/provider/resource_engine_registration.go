@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceEngineRegistration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for registering virtualization and masking engines with DCT.",
+
+		CreateContext: resourceEngineRegistrationCreate,
+		ReadContext:   resourceEngineRegistrationRead,
+		UpdateContext: resourceEngineRegistrationUpdate,
+		DeleteContext: resourceEngineRegistrationDelete,
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{EngineTypeVirtualization, EngineTypeMasking}, false),
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"hashicorp_vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hashicorp_vault_engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hashicorp_vault_secret_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hashicorp_vault_username_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hashicorp_vault_secret_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"insecure_ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"unsafe_ssl_hostname_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"truststore_filename": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"truststore_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags_all": tagsAllSchema(),
+			// Output
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// toEngineRegistrationParameter builds the registration payload from the configured connection options.
+func toEngineRegistrationParameter(d *schema.ResourceData) *dctapi.EngineRegistrationParameter {
+	engineParams := dctapi.NewEngineRegistrationParameter(d.Get("name").(string), d.Get("hostname").(string))
+	if v, has_v := d.GetOk("username"); has_v {
+		engineParams.SetUsername(v.(string))
+	}
+	if v, has_v := d.GetOk("password"); has_v {
+		engineParams.SetPassword(v.(string))
+	}
+	if v, has_v := d.GetOk("hashicorp_vault_id"); has_v {
+		engineParams.SetHashicorpVaultId(v.(string))
+	}
+	if v, has_v := d.GetOk("hashicorp_vault_engine"); has_v {
+		engineParams.SetHashicorpVaultEngine(v.(string))
+	}
+	if v, has_v := d.GetOk("hashicorp_vault_secret_path"); has_v {
+		engineParams.SetHashicorpVaultSecretPath(v.(string))
+	}
+	if v, has_v := d.GetOk("hashicorp_vault_username_key"); has_v {
+		engineParams.SetHashicorpVaultUsernameKey(v.(string))
+	}
+	if v, has_v := d.GetOk("hashicorp_vault_secret_key"); has_v {
+		engineParams.SetHashicorpVaultSecretKey(v.(string))
+	}
+	if v, has_v := d.GetOkExists("insecure_ssl"); has_v {
+		engineParams.SetInsecureSsl(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("unsafe_ssl_hostname_check"); has_v {
+		engineParams.SetUnsafeSslHostnameCheck(v.(bool))
+	}
+	if v, has_v := d.GetOk("truststore_filename"); has_v {
+		engineParams.SetTruststoreFilename(v.(string))
+	}
+	if v, has_v := d.GetOk("truststore_password"); has_v {
+		engineParams.SetTruststorePassword(v.(string))
+	}
+	return engineParams
+}
+
+func resourceEngineRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+
+	engineParams := toEngineRegistrationParameter(d)
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		engineParams.SetTags(tags)
+	}
+
+	tflog.Info(ctx, DLPX+INFO+"Registering engine "+d.Get("hostname").(string))
+	apiRes, httpRes, err := client.ManagementAPI.RegisterEngine(ctx).EngineRegistrationParameter(*engineParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	d.SetId(apiRes.GetId())
+
+	// DCT detects the type of the engine, a registration of the wrong type is undone.
+	detectedType := apiRes.GetType()
+	if engineType, has_v := d.GetOk("type"); has_v && (detectedType == EngineTypeVirtualization || detectedType == EngineTypeMasking) && detectedType != engineType.(string) {
+		tflog.Error(ctx, DLPX+ERROR+"Engine "+apiRes.GetId()+" is a "+detectedType+" engine, unregistering it.")
+		if diags := resourceEngineRegistrationDelete(ctx, d, meta); diags != nil {
+			return diags
+		}
+		d.SetId("")
+		return diag.Errorf("engine %s is a %s engine, not a %s engine.", d.Get("hostname").(string), detectedType, engineType.(string))
+	}
+
+	readDiags := resourceEngineRegistrationRead(ctx, d, meta)
+	if readDiags.HasError() {
+		return readDiags
+	}
+	return diags
+}
+
+func resourceEngineRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	engineId := d.Id()
+
+	res, diags := PollForObjectExistence(ctx, func() (interface{}, *http.Response, error) {
+		return client.ManagementAPI.GetRegisteredEngine(ctx, engineId).Execute()
+	})
+
+	if res == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Engine not found: "+engineId+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	if diags != nil {
+		_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+			return client.ManagementAPI.GetRegisteredEngine(ctx, engineId).Execute()
+		})
+		if diags != nil {
+			tflog.Error(ctx, DLPX+ERROR+"Error in polling of engine for deletion.")
+		} else {
+			tflog.Error(ctx, DLPX+ERROR+"Error reading the engine "+engineId+", removing from state.")
+			d.SetId("")
+		}
+		return nil
+	}
+
+	engine, ok := res.(*dctapi.RegisteredEngine)
+	if !ok {
+		return diag.Errorf("Error occured in type casting.")
+	}
+
+	// credentials are never returned by the API, they are kept from the configuration.
+	d.Set("name", engine.GetName())
+	d.Set("hostname", engine.GetHostname())
+	d.Set("insecure_ssl", engine.GetInsecureSsl())
+	d.Set("unsafe_ssl_hostname_check", engine.GetUnsafeSslHostnameCheck())
+	d.Set("uuid", engine.GetUuid())
+	// the type stays as configured until DCT has detected it.
+	if engineType := engine.GetType(); engineType == EngineTypeVirtualization || engineType == EngineTypeMasking || d.Get("type").(string) == "" {
+		d.Set("type", engineType)
+	}
+	d.Set("version", engine.GetVersion())
+	d.Set("status", engine.GetStatus())
+	d.Set("connection_status", engine.GetConnectionStatus())
+	d.Set("tags", flattenResourceTags(d, meta, engine.GetTags()))
+	d.Set("tags_all", flattenTags(engine.GetTags()))
+
+	return diags
+}
+
+func resourceEngineRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	engineId := d.Id()
+
+	// get the changed keys
+	changedKeys := make([]string, 0, len(d.State().Attributes))
+	for k := range d.State().Attributes {
+		if strings.Contains(k, "tags") { // this is because the changed keys are of the form tag.0.key
+			k = "tags"
+		}
+		if d.HasChange(k) {
+			tflog.Debug(ctx, "changed keys"+k)
+			changedKeys = append(changedKeys, k)
+		}
+	}
+
+	var nonUpdatableField []string
+
+	// check if the changed keys are updatable
+	for _, key := range changedKeys {
+		if !updatableEngineRegistrationKeys[key] {
+			tflog.Debug(ctx, "non updatable field: "+key)
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+
+	// if not updatable keys are provided, error out
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	if d.HasChanges("name", "username", "password", "hashicorp_vault_id", "hashicorp_vault_engine", "hashicorp_vault_secret_path",
+		"hashicorp_vault_username_key", "hashicorp_vault_secret_key", "insecure_ssl", "unsafe_ssl_hostname_check", "truststore_filename", "truststore_password") {
+		apiRes, httpRes, err := client.ManagementAPI.UpdateEngine(ctx, engineId).EngineRegistrationParameter(*toEngineRegistrationParameter(d)).Execute()
+		if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectEngine); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	return resourceEngineRegistrationRead(ctx, d, meta)
+}
+
+func resourceEngineRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	engineId := d.Id()
+
+	tflog.Info(ctx, DLPX+INFO+"Unregistering engine "+engineId)
+	httpRes, err := client.ManagementAPI.UnregisterEngine(ctx, engineId).Execute()
+	if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+		return diags
+	}
+
+	_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+		return client.ManagementAPI.GetRegisteredEngine(ctx, engineId).Execute()
+	})
+
+	return diags
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccEngineRegistration_create_positive(t *testing.T) {
+	hostname := os.Getenv("ENGINE_REGISTRATION_HOSTNAME")
+	username := os.Getenv("ENGINE_REGISTRATION_USERNAME")
+	password := os.Getenv("ENGINE_REGISTRATION_PASSWORD")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccEngineRegistrationPreCheck(t, hostname, username, password) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEngineRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineRegistrationConfig("tf-acc-engine", hostname, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEngineRegistrationExists("delphix_engine_registration.new", hostname),
+					resource.TestCheckResourceAttrSet("delphix_engine_registration.new", "version"),
+					resource.TestCheckResourceAttrSet("delphix_engine_registration.new", "type")),
+			},
+			{
+				Config: testAccEngineRegistrationConfig("tf-acc-engine-renamed", hostname, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("delphix_engine_registration.new", "name", "tf-acc-engine-renamed")),
+			},
+		},
+	})
+}
+
+func testAccEngineRegistrationPreCheck(t *testing.T, hostname string, username string, password string) {
+	testAccPreCheck(t)
+	if hostname == "" {
+		t.Fatal("ENGINE_REGISTRATION_HOSTNAME must be set for engine registration acceptance tests")
+	}
+	if username == "" {
+		t.Fatal("ENGINE_REGISTRATION_USERNAME must be set for engine registration acceptance tests")
+	}
+	if password == "" {
+		t.Fatal("ENGINE_REGISTRATION_PASSWORD must be set for engine registration acceptance tests")
+	}
+}
+
+func testAccEngineRegistrationConfig(name string, hostname string, username string, password string) string {
+	return fmt.Sprintf(`
+	resource "delphix_engine_registration" "new" {
+		name         = "%s"
+		hostname     = "%s"
+		username     = "%s"
+		password     = "%s"
+		insecure_ssl = true
+		tags {
+			key   = "team"
+			value = "qa"
+		}
+	}
+	`, name, hostname, username, password)
+}
+
+func testAccCheckEngineRegistrationExists(n string, hostname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		engineId := rs.Primary.ID
+		if engineId == "" {
+			return fmt.Errorf("No EngineID set")
+		}
+
+		client := testAccProvider.Meta().(*apiClient).client
+		res, _, err := client.ManagementAPI.GetRegisteredEngine(context.Background(), engineId).Execute()
+		if err != nil {
+			return err
+		}
+
+		if res.GetHostname() != hostname {
+			return fmt.Errorf("Engine hostname mismatch, expected %s but got %s", hostname, res.GetHostname())
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEngineRegistrationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "delphix_engine_registration" {
+			continue
+		}
+
+		engineId := rs.Primary.ID
+
+		_, httpResp, _ := client.ManagementAPI.GetRegisteredEngine(context.Background(), engineId).Execute()
+		if httpResp == nil {
+			return fmt.Errorf("Engine has not been unregistered")
+		}
+
+		if httpResp.StatusCode != 404 {
+			return fmt.Errorf("Exepcted a 404 Not Found for an unregistered Engine but got %d", httpResp.StatusCode)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,6 +21,7 @@ func resourceEnvironment() *schema.Resource {
 		ReadContext:   resourceEnvironmentRead,
 		UpdateContext: resourceEnvironmentUpdate,
 		DeleteContext: resourceEnvironmentDelete,
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -71,32 +73,53 @@ func resourceEnvironment() *schema.Resource {
 				Optional: true,
 			},
 			"password": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "password"),
 			},
 			"vault": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"hashicorp_vault_engine": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "hashicorp"),
 			},
 			"hashicorp_vault_secret_path": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "hashicorp"),
 			},
 			"hashicorp_vault_username_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "hashicorp"),
 			},
 			"hashicorp_vault_secret_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "hashicorp"),
+			},
+			"azure_vault_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "azure"),
+			},
+			"azure_vault_username_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "azure"),
+			},
+			"azure_vault_secret_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "azure"),
 			},
 			"cyberark_vault_query_string": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("", "cyberark"),
 			},
 			"use_kerberos_authentication": {
 				Type:     schema.TypeBool,
@@ -111,24 +134,44 @@ func resourceEnvironment() *schema.Resource {
 				Optional: true,
 			},
 			"ase_db_hashicorp_vault_engine": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "hashicorp"),
 			},
 			"ase_db_hashicorp_vault_secret_path": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "hashicorp"),
 			},
 			"ase_db_hashicorp_vault_username_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "hashicorp"),
 			},
 			"ase_db_hashicorp_vault_secret_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "hashicorp"),
+			},
+			"ase_db_azure_vault_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "azure"),
+			},
+			"ase_db_azure_vault_username_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "azure"),
+			},
+			"ase_db_azure_vault_secret_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "azure"),
 			},
 			"ase_db_cyberark_vault_query_string": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "cyberark"),
 			},
 			"ase_db_use_kerberos_authentication": {
 				Type:     schema.TypeBool,
@@ -146,8 +189,9 @@ func resourceEnvironment() *schema.Resource {
 				Optional: true,
 			},
 			"ase_db_password": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("ase_db_", "password"),
 			},
 			"java_home": {
 				Type:     schema.TypeString,
@@ -193,14 +237,28 @@ func resourceEnvironment() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"namespace": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
+			"cascade_enable_disable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cascaded_dataset_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"hosts": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -327,6 +385,15 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 	if v, has_v := d.GetOk("hashicorp_vault_secret_key"); has_v {
 		createEnvParams.SetHashicorpVaultSecretKey(v.(string))
 	}
+	if v, has_v := d.GetOk("azure_vault_name"); has_v {
+		createEnvParams.SetAzureVaultName(v.(string))
+	}
+	if v, has_v := d.GetOk("azure_vault_username_key"); has_v {
+		createEnvParams.SetAzureVaultUsernameKey(v.(string))
+	}
+	if v, has_v := d.GetOk("azure_vault_secret_key"); has_v {
+		createEnvParams.SetAzureVaultSecretKey(v.(string))
+	}
 	if v, has_v := d.GetOk("cyberark_vault_query_string"); has_v {
 		createEnvParams.SetCyberarkVaultQueryString(v.(string))
 	}
@@ -351,6 +418,15 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 	if v, has_v := d.GetOk("ase_db_hashicorp_vault_secret_key"); has_v {
 		createEnvParams.SetAseDbHashicorpVaultSecretKey(v.(string))
 	}
+	if v, has_v := d.GetOk("ase_db_azure_vault_name"); has_v {
+		createEnvParams.SetAseDbAzureVaultName(v.(string))
+	}
+	if v, has_v := d.GetOk("ase_db_azure_vault_username_key"); has_v {
+		createEnvParams.SetAseDbAzureVaultUsernameKey(v.(string))
+	}
+	if v, has_v := d.GetOk("ase_db_azure_vault_secret_key"); has_v {
+		createEnvParams.SetAseDbAzureVaultSecretKey(v.(string))
+	}
 	if v, has_v := d.GetOk("ase_db_cyberark_vault_query_string"); has_v {
 		createEnvParams.SetAseDbCyberarkVaultQueryString(v.(string))
 	}
@@ -381,8 +457,8 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 	if v, has_v := d.GetOk("nfs_addresses"); has_v {
 		createEnvParams.SetNfsAddresses(toStringArray(v))
 	}
-	if v, has_v := d.GetOk("tags"); has_v {
-		createEnvParams.SetTags(toTagArray(v))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		createEnvParams.SetTags(tags)
 	}
 
 	apiReq := client.EnvironmentsAPI.CreateEnvironment(ctx)
@@ -403,6 +479,12 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		d.SetId("")
 		return diag.Errorf("[NOT OK] Env-Create %s. JobId: %s / Error: %s", job_status, apiRes.Job.GetId(), job_err)
 	}
+
+	if v, has_v := d.GetOkExists("enabled"); has_v && !v.(bool) {
+		if diags := setEnvironmentEnabled(ctx, d, meta, false); diags != nil {
+			return diags
+		}
+	}
 	// Get environment info and store state.
 	readDiags := resourceEnvironmentRead(ctx, d, meta)
 	if readDiags.HasError() {
@@ -443,15 +525,139 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("enabled", envRes.GetEnabled())
 	d.Set("hosts", flattenHosts(envRes.GetHosts()))
 	d.Set("repositories", flattenHostRepositories(envRes.GetRepositories()))
+	d.Set("tags", flattenResourceTags(d, meta, envRes.GetTags()))
+	d.Set("tags_all", flattenTags(envRes.GetTags()))
 	return diags
 }
 
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tflog.Info(ctx, DLPX+INFO+"Not Implemented: resourceEnvironmentUpdate")
 	var diags diag.Diagnostics
+
+	if !d.HasChanges("enabled", "tags", "tags_all") {
+		tflog.Info(ctx, DLPX+INFO+"Not Implemented: resourceEnvironmentUpdate")
+	}
+	if d.HasChange("enabled") {
+		if diags := setEnvironmentEnabled(ctx, d, meta, d.Get("enabled").(bool)); diags != nil {
+			old, _ := d.GetChange("enabled")
+			d.Set("enabled", old)
+			return diags
+		}
+	}
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectEnvironment); diags != nil {
+			old, _ := d.GetChange("tags")
+			d.Set("tags", old)
+			return diags
+		}
+	}
+
 	return diags
 }
 
+// setEnvironmentEnabled enables or disables the environment. With cascade_enable_disable, the enabled
+// VDBs and dSources on the environment are disabled before the environment and their IDs recorded in
+// cascaded_dataset_ids. Enabling the environment enables only the recorded datasets, so that datasets
+// disabled on purpose stay disabled, and clears the list.
+func setEnvironmentEnabled(ctx context.Context, d *schema.ResourceData, meta interface{}, enabled bool) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	envId := d.Id()
+
+	var vdbs []dctapi.VDB
+	var dsources []dctapi.DSource
+	cascadedDatasetIds := []string{}
+	recordedDatasetIds := map[string]bool{}
+	for _, id := range d.Get("cascaded_dataset_ids").([]interface{}) {
+		recordedDatasetIds[id.(string)] = true
+	}
+
+	if (!enabled && d.Get("cascade_enable_disable").(bool)) || (enabled && len(recordedDatasetIds) != 0) {
+		var diags diag.Diagnostics
+		vdbs, diags = searchVdbs(ctx, client, "environment_id eq "+filterLiteral(envId))
+		if diags != nil {
+			return diags
+		}
+		dsources, diags = searchEnvironmentDsources(ctx, client, envId)
+		if diags != nil {
+			return diags
+		}
+	}
+
+	if !enabled {
+		for _, vdb := range vdbs {
+			if vdb.GetEnabled() {
+				if diags := disableVDB(ctx, client, vdb.GetId()); diags != nil {
+					d.Set("cascaded_dataset_ids", cascadedDatasetIds)
+					return diags
+				}
+				cascadedDatasetIds = append(cascadedDatasetIds, vdb.GetId())
+			}
+		}
+		for _, dsource := range dsources {
+			if dsource.GetEnabled() {
+				if diags := disableDsource(ctx, client, dsource.GetId()); diags != nil {
+					d.Set("cascaded_dataset_ids", cascadedDatasetIds)
+					return diags
+				}
+				cascadedDatasetIds = append(cascadedDatasetIds, dsource.GetId())
+			}
+		}
+		d.Set("cascaded_dataset_ids", cascadedDatasetIds)
+		if diags := disableEnvironment(ctx, client, envId); diags != nil {
+			return diags
+		}
+		if len(cascadedDatasetIds) != 0 {
+			tflog.Info(ctx, DLPX+INFO+"Datasets disabled with environment "+envId+": "+strings.Join(cascadedDatasetIds, ", "))
+		}
+	} else {
+		if diags := enableEnvironment(ctx, client, envId); diags != nil {
+			return diags
+		}
+		for _, dsource := range dsources {
+			if recordedDatasetIds[dsource.GetId()] && !dsource.GetEnabled() {
+				if diags := enableDsource(ctx, client, dsource.GetId()); diags != nil {
+					return diags
+				}
+				cascadedDatasetIds = append(cascadedDatasetIds, dsource.GetId())
+			}
+		}
+		for _, vdb := range vdbs {
+			if recordedDatasetIds[vdb.GetId()] && !vdb.GetEnabled() {
+				if diags := enableVDB(ctx, client, vdb.GetId()); diags != nil {
+					return diags
+				}
+				cascadedDatasetIds = append(cascadedDatasetIds, vdb.GetId())
+			}
+		}
+		if len(cascadedDatasetIds) != 0 {
+			tflog.Info(ctx, DLPX+INFO+"Datasets enabled with environment "+envId+": "+strings.Join(cascadedDatasetIds, ", "))
+		}
+		d.Set("cascaded_dataset_ids", []string{})
+	}
+
+	d.Set("enabled", enabled)
+	return nil
+}
+
+// searchEnvironmentDsources returns the dSources linked from the sources on the environment.
+func searchEnvironmentDsources(ctx context.Context, client *dctapi.APIClient, envId string) ([]dctapi.DSource, diag.Diagnostics) {
+	sources, diags := searchSources(ctx, client, "environment_id eq "+filterLiteral(envId))
+	if diags != nil {
+		return nil, diags
+	}
+	dsources := []dctapi.DSource{}
+	for _, source := range sources {
+		if !source.GetIsDsource() {
+			continue
+		}
+		sourceDsources, diags := searchDsources(ctx, client, "source_id eq "+filterLiteral(source.GetId()))
+		if diags != nil {
+			return nil, diags
+		}
+		dsources = append(dsources, sourceDsources...)
+	}
+	return dsources, nil
+}
+
 func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client := meta.(*apiClient).client
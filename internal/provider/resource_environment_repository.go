@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEnvironmentRepository() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource for managing custom repositories on an environment.",
+
+		CreateContext: resourceEnvironmentRepositoryCreate,
+		ReadContext:   resourceEnvironmentRepositoryRead,
+		UpdateContext: resourceEnvironmentRepositoryUpdate,
+		DeleteContext: resourceEnvironmentRepositoryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"database_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"installation_home": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"oracle_base": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bits": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"allow_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			// Output
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_discovered": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentRepositoryImport,
+		},
+	}
+}
+
+func resourceEnvironmentRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+
+	envId := d.Get("environment_id").(string)
+
+	// a discovered repository is adopted and only its provisioning flags are managed.
+	if v, has_v := d.GetOk("repository_id"); has_v {
+		d.SetId(v.(string))
+		d.Set("is_discovered", true)
+		if diags := updateEnvironmentRepository(ctx, d, meta); diags != nil {
+			d.SetId("")
+			return diags
+		}
+		readDiags := resourceEnvironmentRepositoryRead(ctx, d, meta)
+		if readDiags.HasError() {
+			return readDiags
+		}
+		return diags
+	}
+
+	if _, has_v := d.GetOk("installation_home"); !has_v {
+		return diag.Errorf("installation_home is required when repository_id is not set.")
+	}
+
+	createRepositoryParams := dctapi.NewRepositoryCreateParameters(d.Get("installation_home").(string))
+
+	if v, has_v := d.GetOk("name"); has_v {
+		createRepositoryParams.SetName(v.(string))
+	}
+	if v, has_v := d.GetOk("database_type"); has_v {
+		createRepositoryParams.SetDatabaseType(v.(string))
+	}
+	if v, has_v := d.GetOk("version"); has_v {
+		createRepositoryParams.SetVersion(v.(string))
+	}
+	if v, has_v := d.GetOk("oracle_base"); has_v {
+		createRepositoryParams.SetOracleBase(v.(string))
+	}
+	if v, has_v := d.GetOk("bits"); has_v {
+		createRepositoryParams.SetBits(int32(v.(int)))
+	}
+	if v, has_v := d.GetOkExists("allow_provisioning"); has_v {
+		createRepositoryParams.SetAllowProvisioning(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("is_staging"); has_v {
+		createRepositoryParams.SetIsStaging(v.(bool))
+	}
+
+	apiRes, httpRes, err := client.EnvironmentsAPI.CreateRepository(ctx, envId).RepositoryCreateParameters(*createRepositoryParams).Execute()
+
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	d.SetId(apiRes.GetRepositoryId())
+	d.Set("is_discovered", false)
+
+	job_status, job_err := PollJobStatus(apiRes.Job.GetId(), ctx, client)
+	if job_err != "" {
+		tflog.Error(ctx, DLPX+ERROR+"Job Polling failed but continuing with repository creation. Error: "+job_err)
+	}
+
+	if isJobTerminalFailure(job_status) {
+		d.SetId("")
+		return diag.Errorf("[NOT OK] Repository-Create %s. JobId: %s / Error: %s", job_status, apiRes.Job.GetId(), job_err)
+	}
+
+	readDiags := resourceEnvironmentRepositoryRead(ctx, d, meta)
+	if readDiags.HasError() {
+		return readDiags
+	}
+	return diags
+}
+
+func resourceEnvironmentRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	envId := d.Get("environment_id").(string)
+	repositoryId := d.Id()
+
+	apiRes, diags := PollForObjectExistence(ctx, func() (interface{}, *http.Response, error) {
+		return client.EnvironmentsAPI.GetEnvironmentById(ctx, envId).Execute()
+	})
+
+	if apiRes == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Environment not found: "+envId+", removing repository from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	if diags != nil {
+		tflog.Error(ctx, DLPX+ERROR+"Error reading environment "+envId+" for repository "+repositoryId+".")
+		return diags
+	}
+
+	envRes, _ := apiRes.(*dctapi.Environment)
+	repository := findEnvironmentRepository(envRes.GetRepositories(), repositoryId)
+	if repository == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Repository not found: "+repositoryId+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("id", repository.GetId())
+	d.Set("repository_id", repository.GetId())
+	d.Set("name", repository.GetName())
+	d.Set("database_type", repository.GetDatabaseType())
+	d.Set("version", repository.GetVersion())
+	d.Set("allow_provisioning", repository.GetAllowProvisioning())
+	d.Set("is_staging", repository.GetIsStaging())
+
+	return diags
+}
+
+func resourceEnvironmentRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// get the changed keys
+	changedKeys := make([]string, 0, len(d.State().Attributes))
+	for k := range d.State().Attributes {
+		if d.HasChange(k) {
+			changedKeys = append(changedKeys, k)
+		}
+	}
+
+	var nonUpdatableField []string
+	for _, key := range changedKeys {
+		if !updatableEnvironmentRepositoryKeys[key] {
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	if diags := updateEnvironmentRepository(ctx, d, meta); diags != nil {
+		revertChanges(d, changedKeys)
+		return diags
+	}
+
+	return resourceEnvironmentRepositoryRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	envId := d.Get("environment_id").(string)
+	repositoryId := d.Id()
+
+	// discovered repositories belong to the environment, only the custom ones are removed.
+	if d.Get("is_discovered").(bool) {
+		tflog.Info(ctx, DLPX+INFO+"Repository "+repositoryId+" was discovered on the environment, removing from state only.")
+		return nil
+	}
+
+	apiRes, httpRes, err := client.EnvironmentsAPI.DeleteRepository(ctx, envId, repositoryId).Execute()
+
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	job_status, job_err := PollJobStatus(apiRes.Job.GetId(), ctx, client)
+	if job_err != "" {
+		tflog.Warn(ctx, DLPX+WARN+"Job Polling failed but continuing with repository deletion. Error: "+job_err)
+	}
+	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+	if isJobTerminalFailure(job_status) {
+		return diag.Errorf("[NOT OK] Repository-Delete %s. JobId: %s / Error: %s", job_status, apiRes.Job.GetId(), job_err)
+	}
+
+	return nil
+}
+
+// updateEnvironmentRepository sends the changed repository attributes to the repository update API.
+func updateEnvironmentRepository(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	envId := d.Get("environment_id").(string)
+	repositoryId := d.Id()
+
+	updateRepositoryParams := dctapi.NewRepositoryUpdateParameters()
+
+	if v, has_v := d.GetOk("name"); has_v && d.HasChange("name") {
+		updateRepositoryParams.SetName(v.(string))
+	}
+	if v, has_v := d.GetOk("version"); has_v && d.HasChange("version") {
+		updateRepositoryParams.SetVersion(v.(string))
+	}
+	if v, has_v := d.GetOk("oracle_base"); has_v && d.HasChange("oracle_base") {
+		updateRepositoryParams.SetOracleBase(v.(string))
+	}
+	if v, has_v := d.GetOk("bits"); has_v && d.HasChange("bits") {
+		updateRepositoryParams.SetBits(int32(v.(int)))
+	}
+	if v, has_v := d.GetOkExists("allow_provisioning"); has_v {
+		updateRepositoryParams.SetAllowProvisioning(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("is_staging"); has_v {
+		updateRepositoryParams.SetIsStaging(v.(bool))
+	}
+
+	apiRes, httpRes, err := client.EnvironmentsAPI.UpdateRepository(ctx, envId, repositoryId).RepositoryUpdateParameters(*updateRepositoryParams).Execute()
+
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	job_status, job_err := PollJobStatus(apiRes.Job.GetId(), ctx, client)
+	if job_err != "" {
+		tflog.Warn(ctx, DLPX+WARN+"Repository Update Job Polling failed but continuing with update. Error: "+job_err)
+	}
+	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+	if isJobTerminalFailure(job_status) {
+		return diag.Errorf("[NOT OK] Repository-Update %s. JobId: %s / Error: %s", job_status, apiRes.Job.GetId(), job_err)
+	}
+	return nil
+}
+
+// resourceEnvironmentRepositoryImport imports a repository from an ID of the form <environment_id>/<repository_id>.
+// Imported repositories are treated as discovered, so destroying the resource leaves them on the environment.
+func resourceEnvironmentRepositoryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %s, expected <environment_id>/<repository_id>", d.Id())
+	}
+	d.SetId(parts[1])
+	d.Set("environment_id", parts[0])
+	d.Set("is_discovered", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+func findEnvironmentRepository(repos []dctapi.Repository, repositoryId string) *dctapi.Repository {
+	for i := range repos {
+		if repos[i].GetId() == repositoryId {
+			return &repos[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccEnvironmentRepository_positive(t *testing.T) {
+	envId := os.Getenv("ACC_REPO_ENV_ID")
+	installationHome := os.Getenv("ACC_REPO_INSTALLATION_HOME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccEnvironmentRepositoryPreCheck(t, envId, installationHome) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEnvironmentRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckEnvironmentRepositoryConfigBasic(envId, installationHome, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentRepositoryExists("delphix_environment_repository.new_repo", true),
+					resource.TestCheckResourceAttr("delphix_environment_repository.new_repo", "allow_provisioning", "true")),
+			},
+			{
+				Config: testAccCheckEnvironmentRepositoryConfigBasic(envId, installationHome, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentRepositoryExists("delphix_environment_repository.new_repo", false),
+					resource.TestCheckResourceAttr("delphix_environment_repository.new_repo", "allow_provisioning", "false")),
+			},
+			{
+				ResourceName:            "delphix_environment_repository.new_repo",
+				ImportState:             true,
+				ImportStateIdPrefix:     envId + "/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"installation_home", "is_discovered"},
+			},
+		},
+	})
+}
+
+func testAccEnvironmentRepositoryPreCheck(t *testing.T, envId string, installationHome string) {
+	testAccPreCheck(t)
+	if envId == "" {
+		t.Fatal("ACC_REPO_ENV_ID must be set for repository acceptance tests")
+	}
+	if installationHome == "" {
+		t.Fatal("ACC_REPO_INSTALLATION_HOME must be set for repository acceptance tests")
+	}
+}
+
+func testAccCheckEnvironmentRepositoryConfigBasic(envId string, installationHome string, allowProvisioning bool) string {
+	return fmt.Sprintf(`
+	resource "delphix_environment_repository" "new_repo" {
+		environment_id     = "%s"
+		installation_home  = "%s"
+		allow_provisioning = %t
+	}
+	`, envId, escape(installationHome), allowProvisioning)
+}
+
+func testAccCheckEnvironmentRepositoryExists(n string, allowProvisioning bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		repositoryId := rs.Primary.ID
+		if repositoryId == "" {
+			return fmt.Errorf("No RepositoryID set")
+		}
+
+		client := testAccProvider.Meta().(*apiClient).client
+		res, _, err := client.EnvironmentsAPI.GetEnvironmentById(context.Background(), rs.Primary.Attributes["environment_id"]).Execute()
+		if err != nil {
+			return err
+		}
+
+		repository := findEnvironmentRepository(res.GetRepositories(), repositoryId)
+		if repository == nil {
+			return fmt.Errorf("Repository %s not found on environment", repositoryId)
+		}
+		if repository.GetAllowProvisioning() != allowProvisioning {
+			return fmt.Errorf("allow_provisioning %t does not match %t", repository.GetAllowProvisioning(), allowProvisioning)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentRepositoryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "delphix_environment_repository" {
+			continue
+		}
+
+		res, _, err := client.EnvironmentsAPI.GetEnvironmentById(context.Background(), rs.Primary.Attributes["environment_id"]).Execute()
+		if err != nil {
+			return err
+		}
+
+		if findEnvironmentRepository(res.GetRepositories(), rs.Primary.ID) != nil {
+			return fmt.Errorf("Repository %s has not been deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,627 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	MssqlExternalBackup        string = "external_backup"
+	MssqlDelphixManagedBackup  string = "delphix_managed_backup"
+	MssqlStagingPush           string = "staging_push"
+	MssqlSyncStrategyExternal  string = "ExternalBackup"
+	MssqlSyncStrategyManaged   string = "DelphixManagedBackup"
+	MssqlSyncStrategyUnmanaged string = "StagingPush"
+)
+
+func resourceMssqlDsource() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource for Microsoft SQL Server dSource creation.",
+
+		CreateContext: resourceMssqlDsourceCreate,
+		ReadContext:   resourceMssqlDsourceRead,
+		UpdateContext: resourceMssqlDsourceUpdate,
+		DeleteContext: resourceDsourceDelete,
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rollback_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rollback_on_failure_phases": rollbackOnFailurePhasesSchema(),
+			"delete_dependents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if old != new {
+						tflog.Info(context.Background(), "updating source_value is not allowed. plan changes are suppressed")
+					}
+					return d.Id() != ""
+				},
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if old != new {
+						tflog.Info(context.Background(), "updating group_id is not allowed. plan changes are suppressed")
+					}
+					return d.Id() != ""
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_sync_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"make_current_account_owner": createOnlySchema(schema.TypeBool),
+			"sync_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      MssqlExternalBackup,
+				ValidateFunc: validation.StringInSlice([]string{MssqlExternalBackup, MssqlDelphixManagedBackup, MssqlStagingPush}, false),
+			},
+			"staging_environment": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ppt_repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ppt_host_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_host_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"staging_database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"staging_pre_script": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"staging_post_script": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encryption_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"external_file_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shared_backup_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"backup_secondary_location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"validated_sync_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TRANSACTION_LOG", "FULL_OR_DIFFERENTIAL", "FULL", "NONE"}, false),
+			},
+			"delphix_managed_backup_compression_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"delphix_managed_backup_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"primary", "secondary_only", "prefer_secondary"}, false),
+			},
+			"tags":          dsourceTagsSchema(),
+			"tags_all":      tagsAllSchema(),
+			"ops_pre_sync":  dsourceOperationsSchema(),
+			"ops_post_sync": dsourceOperationsSchema(),
+			// Output
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_detached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_time": {
+				Type:     schema.TypeInt,
+				Default:  0,
+				Optional: true,
+			},
+			"snapshot_wait_failure_action": snapshotWaitFailureActionSchema(),
+			"skip_wait_for_snapshot_creation": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func toMssqlBackupLocationArray(array interface{}) []dctapi.MSSQLBackupLocation {
+	items := []dctapi.MSSQLBackupLocation{}
+	for _, item := range array.([]interface{}) {
+		item_map := item.(map[string]interface{})
+		backupLocation := dctapi.NewMSSQLBackupLocation(item_map["backup_location"].(string))
+		if item_map["backup_secondary_location"].(string) != "" {
+			backupLocation.SetBackupSecondaryLocation(item_map["backup_secondary_location"].(string))
+		}
+		items = append(items, *backupLocation)
+	}
+	return items
+}
+
+func flattenMssqlBackupLocations(locations []dctapi.MSSQLBackupLocation) []interface{} {
+	if locations != nil {
+		returnedLocations := make([]interface{}, len(locations))
+		for i, location := range locations {
+			returnedLocation := make(map[string]interface{})
+			returnedLocation["backup_location"] = location.GetBackupLocation()
+			returnedLocation["backup_secondary_location"] = location.GetBackupSecondaryLocation()
+			returnedLocations[i] = returnedLocation
+		}
+		return returnedLocations
+	}
+	return make([]interface{}, 0)
+}
+
+func resourceMssqlDsourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+
+	var apiRes *dctapi.LinkDSourceResponse
+	var httpRes *http.Response
+	var err error
+
+	syncStrategy := d.Get("sync_strategy").(string)
+	if syncStrategy == MssqlStagingPush {
+		if _, has_v := d.GetOk("source_value"); has_v {
+			return diag.Errorf("source_value is not supported for sync_strategy = '%s'", MssqlStagingPush)
+		}
+		stagingPushParameters := dctapi.NewMSSQLDSourceStagingPushLinkSourceParameters(d.Get("staging_environment").(string), d.Get("ppt_repository").(string))
+
+		if v, has_v := d.GetOk("name"); has_v {
+			stagingPushParameters.SetName(v.(string))
+		}
+		if v, has_v := d.GetOk("group_id"); has_v {
+			stagingPushParameters.SetGroupId(v.(string))
+		}
+		if v, has_v := d.GetOk("description"); has_v {
+			stagingPushParameters.SetDescription(v.(string))
+		}
+		if v, has_v := d.GetOkExists("log_sync_enabled"); has_v {
+			stagingPushParameters.SetLogSyncEnabled(v.(bool))
+		}
+		if v, has_v := d.GetOkExists("make_current_account_owner"); has_v {
+			stagingPushParameters.SetMakeCurrentAccountOwner(v.(bool))
+		}
+		if v, has_v := d.GetOk("staging_database_name"); has_v {
+			stagingPushParameters.SetStagingDatabaseName(v.(string))
+		}
+		if v, has_v := d.GetOk("ppt_host_user"); has_v {
+			stagingPushParameters.SetPptHostUser(v.(string))
+		}
+		if v, has_v := d.GetOk("staging_pre_script"); has_v {
+			stagingPushParameters.SetStagingPreScript(v.(string))
+		}
+		if v, has_v := d.GetOk("staging_post_script"); has_v {
+			stagingPushParameters.SetStagingPostScript(v.(string))
+		}
+		if v, has_v := d.GetOk("encryption_key"); has_v {
+			stagingPushParameters.SetEncryptionKey(v.(string))
+		}
+		if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+			stagingPushParameters.SetTags(tags)
+		}
+		if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+			stagingPushParameters.SetOpsPreSync(toSourceOperationArray(v))
+		}
+		if v, has_v := d.GetOk("ops_post_sync"); has_v {
+			stagingPushParameters.SetOpsPostSync(toSourceOperationArray(v))
+		}
+
+		apiRes, httpRes, err = client.DSourcesAPI.LinkMssqlStagingPushDatabase(ctx).MSSQLDSourceStagingPushLinkSourceParameters(*stagingPushParameters).Execute()
+	} else {
+		if _, has_v := d.GetOk("source_value"); !has_v {
+			return diag.Errorf("source_value is required for sync_strategy = '%s'", syncStrategy)
+		}
+		mssqlDSourceLinkSourceParameters := dctapi.NewMSSQLDSourceLinkSourceParameters(d.Get("source_value").(string), d.Get("ppt_repository").(string))
+
+		if syncStrategy == MssqlDelphixManagedBackup {
+			mssqlDSourceLinkSourceParameters.SetSyncStrategy(MssqlSyncStrategyManaged)
+		} else {
+			mssqlDSourceLinkSourceParameters.SetSyncStrategy(MssqlSyncStrategyExternal)
+		}
+		if v, has_v := d.GetOk("name"); has_v {
+			mssqlDSourceLinkSourceParameters.SetName(v.(string))
+		}
+		if v, has_v := d.GetOk("group_id"); has_v {
+			mssqlDSourceLinkSourceParameters.SetGroupId(v.(string))
+		}
+		if v, has_v := d.GetOk("description"); has_v {
+			mssqlDSourceLinkSourceParameters.SetDescription(v.(string))
+		}
+		if v, has_v := d.GetOkExists("log_sync_enabled"); has_v {
+			mssqlDSourceLinkSourceParameters.SetLogSyncEnabled(v.(bool))
+		}
+		if v, has_v := d.GetOkExists("make_current_account_owner"); has_v {
+			mssqlDSourceLinkSourceParameters.SetMakeCurrentAccountOwner(v.(bool))
+		}
+		if v, has_v := d.GetOk("staging_environment"); has_v {
+			mssqlDSourceLinkSourceParameters.SetStagingEnvironment(v.(string))
+		}
+		if v, has_v := d.GetOk("ppt_host_user"); has_v {
+			mssqlDSourceLinkSourceParameters.SetPptHostUser(v.(string))
+		}
+		if v, has_v := d.GetOk("source_host_user"); has_v {
+			mssqlDSourceLinkSourceParameters.SetSourceHostUser(v.(string))
+		}
+		if v, has_v := d.GetOk("staging_pre_script"); has_v {
+			mssqlDSourceLinkSourceParameters.SetStagingPreScript(v.(string))
+		}
+		if v, has_v := d.GetOk("staging_post_script"); has_v {
+			mssqlDSourceLinkSourceParameters.SetStagingPostScript(v.(string))
+		}
+		if v, has_v := d.GetOk("encryption_key"); has_v {
+			mssqlDSourceLinkSourceParameters.SetEncryptionKey(v.(string))
+		}
+		if v, has_v := d.GetOk("external_file_path"); has_v {
+			mssqlDSourceLinkSourceParameters.SetExternalFilePath(v.(string))
+		}
+		if v, has_v := d.GetOk("shared_backup_locations"); has_v {
+			mssqlDSourceLinkSourceParameters.SetSharedBackupLocations(toMssqlBackupLocationArray(v))
+		}
+		if v, has_v := d.GetOk("validated_sync_mode"); has_v {
+			mssqlDSourceLinkSourceParameters.SetValidatedSyncMode(v.(string))
+		}
+		if v, has_v := d.GetOkExists("delphix_managed_backup_compression_enabled"); has_v {
+			mssqlDSourceLinkSourceParameters.SetDelphixManagedBackupCompressionEnabled(v.(bool))
+		}
+		if v, has_v := d.GetOk("delphix_managed_backup_policy"); has_v {
+			mssqlDSourceLinkSourceParameters.SetDelphixManagedBackupPolicy(v.(string))
+		}
+		if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+			mssqlDSourceLinkSourceParameters.SetTags(tags)
+		}
+		if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+			mssqlDSourceLinkSourceParameters.SetOpsPreSync(toSourceOperationArray(v))
+		}
+		if v, has_v := d.GetOk("ops_post_sync"); has_v {
+			mssqlDSourceLinkSourceParameters.SetOpsPostSync(toSourceOperationArray(v))
+		}
+
+		apiRes, httpRes, err = client.DSourcesAPI.LinkMssqlDatabase(ctx).MSSQLDSourceLinkSourceParameters(*mssqlDSourceLinkSourceParameters).Execute()
+	}
+
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	d.SetId(apiRes.GetDsourceId())
+
+	snapshotDiags := linkDsourceAndWait(ctx, d, meta, apiRes.Job, resourceMssqlDsourceRead, true)
+	if snapshotDiags.HasError() {
+		return snapshotDiags
+	}
+
+	readDiags := resourceMssqlDsourceRead(ctx, d, meta)
+
+	if readDiags.HasError() {
+		return readDiags
+	}
+
+	return append(diags, snapshotDiags...)
+}
+
+func resourceMssqlDsourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	dsource_id := d.Id()
+
+	res, diags := PollForObjectExistence(ctx, func() (interface{}, *http.Response, error) {
+		return client.DSourcesAPI.GetDsourceById(ctx, dsource_id).Execute()
+	})
+
+	if res == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Dsource not found: "+dsource_id+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	if diags != nil {
+		_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+			return client.DSourcesAPI.GetDsourceById(ctx, dsource_id).Execute()
+		})
+		// This would imply error in poll for deletion so we just log and exit.
+		if diags != nil {
+			tflog.Error(ctx, DLPX+ERROR+"Error in polling of dSource for deletion.")
+		} else {
+			// diags will be nil in case of successful poll for deletion logic aka 404
+			tflog.Error(ctx, DLPX+ERROR+"Error reading the dSource "+dsource_id+", removing from state.")
+			d.SetId("")
+		}
+
+		return nil
+	}
+
+	result, ok := res.(*dctapi.DSource)
+	if !ok {
+		return diag.Errorf("Error occured in type casting.")
+	}
+
+	_, rollback_on_failure_exists := d.GetOk("rollback_on_failure")
+	if !rollback_on_failure_exists {
+		// its an import or upgrade, set to default value
+		d.Set("rollback_on_failure", false)
+	}
+
+	ops_pre_sync_Raw, _ := d.Get("ops_pre_sync").([]interface{})
+	oldOpsPreSync := toSourceOperationArray(ops_pre_sync_Raw)
+
+	ops_post_sync_Raw, _ := d.Get("ops_post_sync").([]interface{})
+	oldOpsPostSync := toSourceOperationArray(ops_post_sync_Raw)
+
+	d.Set("id", result.GetId())
+	d.Set("database_type", result.GetDatabaseType())
+	d.Set("name", result.GetName())
+	d.Set("description", result.GetDescription())
+	d.Set("database_version", result.GetDatabaseVersion())
+	d.Set("data_uuid", result.GetDataUuid())
+	d.Set("creation_date", result.GetCreationDate().String())
+	d.Set("group_name", result.GetGroupName())
+	d.Set("enabled", result.GetEnabled())
+	d.Set("is_detached", result.GetIsDetached())
+	d.Set("engine_id", result.GetEngineId())
+	d.Set("source_id", result.GetSourceId())
+	d.Set("status", result.GetStatus())
+	d.Set("engine_name", result.GetEngineName())
+	d.Set("current_timeflow_id", result.GetCurrentTimeflowId())
+	d.Set("log_sync_enabled", result.GetLogsyncEnabled())
+	d.Set("tags", flattenResourceTags(d, meta, result.GetTags()))
+	d.Set("tags_all", flattenTags(result.GetTags()))
+	d.Set("ops_pre_sync", flattenDSourceHooks(result.GetHooks().OpsPreSync, oldOpsPreSync))
+	d.Set("ops_post_sync", flattenDSourceHooks(result.GetHooks().OpsPostSync, oldOpsPostSync))
+	return diags
+}
+
+func resourceMssqlDsourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+	updateMssqlDsource := dctapi.NewUpdateMSSQLDsourceParameters()
+
+	dsourceId := d.Get("id").(string)
+
+	// get the changed keys
+	changedKeys := make([]string, 0, len(d.State().Attributes))
+	for k := range d.State().Attributes {
+		if strings.Contains(k, "tags") { // this is because the changed keys are of the form tag.0.keydi
+			k = "tags"
+		}
+		if strings.Contains(k, "ops_pre_sync") {
+			k = "ops_pre_sync"
+		}
+		if strings.Contains(k, "ops_post_sync") {
+			k = "ops_post_sync"
+		}
+		if strings.Contains(k, "shared_backup_locations") {
+			k = "shared_backup_locations"
+		}
+		if d.HasChange(k) {
+			tflog.Debug(ctx, "changed keys"+k)
+			changedKeys = append(changedKeys, k)
+		}
+	}
+
+	var nonUpdatableField []string
+
+	// check if the changed keys are updatable
+	for _, key := range changedKeys {
+		if !updatableMssqlDsourceKeys[key] {
+			tflog.Debug(ctx, "non updatable field: "+key)
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+
+	// if not updatable keys are provided, error out
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	// set changed params in the updateMssqlDsource
+	if d.HasChange("name") {
+		updateMssqlDsource.SetName(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateMssqlDsource.SetDescription(d.Get("description").(string))
+	}
+	if d.HasChange("ppt_host_user") {
+		updateMssqlDsource.SetPptHostUser(d.Get("ppt_host_user").(string))
+	}
+	if d.HasChange("source_host_user") {
+		updateMssqlDsource.SetSourceHostUser(d.Get("source_host_user").(string))
+	}
+	if d.HasChange("staging_pre_script") {
+		updateMssqlDsource.SetStagingPreScript(d.Get("staging_pre_script").(string))
+	}
+	if d.HasChange("staging_post_script") {
+		updateMssqlDsource.SetStagingPostScript(d.Get("staging_post_script").(string))
+	}
+	if d.HasChange("encryption_key") {
+		updateMssqlDsource.SetEncryptionKey(d.Get("encryption_key").(string))
+	}
+	if d.HasChange("external_file_path") {
+		updateMssqlDsource.SetExternalFilePath(d.Get("external_file_path").(string))
+	}
+	if d.HasChange("shared_backup_locations") {
+		updateMssqlDsource.SetSharedBackupLocations(toMssqlBackupLocationArray(d.Get("shared_backup_locations")))
+	}
+	if d.HasChange("validated_sync_mode") {
+		updateMssqlDsource.SetValidatedSyncMode(d.Get("validated_sync_mode").(string))
+	}
+	if d.HasChange("delphix_managed_backup_compression_enabled") {
+		updateMssqlDsource.SetDelphixManagedBackupCompressionEnabled(d.Get("delphix_managed_backup_compression_enabled").(bool))
+	}
+	if d.HasChange("delphix_managed_backup_policy") {
+		updateMssqlDsource.SetDelphixManagedBackupPolicy(d.Get("delphix_managed_backup_policy").(string))
+	}
+
+	// update hooks
+	if d.HasChanges("ops_pre_sync", "ops_post_sync") {
+		ndsh := dctapi.NewDSourceHooks()
+
+		if d.HasChange("ops_pre_sync") {
+			if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+				ndsh.SetOpsPreSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPreSync([]dctapi.Hook{})
+			}
+		}
+
+		if d.HasChange("ops_post_sync") {
+			if v, has_v := d.GetOk("ops_post_sync"); has_v {
+				ndsh.SetOpsPostSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPostSync([]dctapi.Hook{})
+			}
+		}
+
+		updateMssqlDsource.SetHooks(*ndsh)
+	}
+
+	if hasDsourceParameterChanges(changedKeys) {
+		res, httpRes, err := client.DSourcesAPI.UpdateMssqlDsourceById(ctx, dsourceId).UpdateMSSQLDsourceParameters(*updateMssqlDsource).Execute()
+
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			// revert and set the old value to the changed keys
+			revertChanges(d, changedKeys)
+			return diags
+		}
+
+		job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
+		if job_err != "" {
+			tflog.Warn(ctx, DLPX+WARN+"Dsource Update Job Polling failed but continuing with update. Error: "+job_err)
+		}
+		tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+		if isJobTerminalFailure(job_status) {
+			return diag.Errorf("[NOT OK] Dsource-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectDsource); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMssqlDsource_create_positive(t *testing.T) {
+	sourcevalue := os.Getenv("MSSQL_DSOURCE_SOURCE_VALUE")
+	groupId := os.Getenv("MSSQL_DSOURCE_GROUP_ID")
+	name := os.Getenv("MSSQL_DSOURCE_NAME")
+	stagingEnv := os.Getenv("MSSQL_DSOURCE_STAGING_ENVIRONMENT")
+	pptRepository := os.Getenv("MSSQL_DSOURCE_PPT_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testMssqlDsourcePreCheck(t, sourcevalue, groupId, name, stagingEnv, pptRepository)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testMssqlDsourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMssqlDsourceBasic(name, sourcevalue, groupId, stagingEnv, pptRepository),
+				Check: resource.ComposeTestCheckFunc(
+					testOracleDsourceExists("delphix_mssql_dsource.test_mssql_dsource", sourcevalue),
+					resource.TestCheckResourceAttr("delphix_mssql_dsource.test_mssql_dsource", "source_id", sourcevalue)),
+			},
+			{
+				// positive update test case
+				Config: testMssqlDsourceBasic("update_name", sourcevalue, groupId, stagingEnv, pptRepository),
+				Check: resource.ComposeTestCheckFunc(
+					testOracleDsourceExists("delphix_mssql_dsource.test_mssql_dsource", sourcevalue),
+					resource.TestCheckResourceAttr("delphix_mssql_dsource.test_mssql_dsource", "name", "update_name")),
+			},
+			{
+				// negative update test case
+				Config:      testMssqlDsourceBasic("update_name", sourcevalue, groupId, "non-existent", pptRepository),
+				ExpectError: regexp.MustCompile(`.*`),
+			},
+		},
+	})
+}
+
+func testMssqlDsourcePreCheck(t *testing.T, sourceId string, groupId string, name string, stagingEnv string, pptRepository string) {
+	testAccPreCheck(t)
+	if sourceId == "" {
+		t.Fatal("MSSQL_DSOURCE_SOURCE_VALUE must be set for env acceptance tests")
+	}
+	if groupId == "" {
+		t.Fatal("MSSQL_DSOURCE_GROUP_ID must be set for env acceptance tests")
+	}
+	if name == "" {
+		t.Fatal("MSSQL_DSOURCE_NAME must be set for env acceptance tests")
+	}
+	if stagingEnv == "" {
+		t.Fatal("MSSQL_DSOURCE_STAGING_ENVIRONMENT must be set for env acceptance tests")
+	}
+	if pptRepository == "" {
+		t.Fatal("MSSQL_DSOURCE_PPT_REPOSITORY must be set for env acceptance tests")
+	}
+}
+
+func testMssqlDsourceBasic(name string, sourceValue string, groupId string, stagingEnv string, pptRepository string) string {
+	return fmt.Sprintf(`
+resource "delphix_mssql_dsource" "test_mssql_dsource" {
+  name                = "%s"
+  source_value        = "%s"
+  group_id            = "%s"
+  staging_environment = "%s"
+  ppt_repository      = "%s"
+}
+	`, name, sourceValue, groupId, stagingEnv, pptRepository)
+}
+
+func testMssqlDsourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "delphix_mssql_dsource" {
+			continue
+		}
+
+		dsourceId := rs.Primary.ID
+
+		_, httpResp, _ := client.DSourcesAPI.GetDsourceById(context.Background(), dsourceId).Execute()
+		if httpResp == nil {
+			return fmt.Errorf("Dsource has not been deleted")
+		}
+
+		if httpResp.StatusCode != 404 {
+			return fmt.Errorf("Exepcted a 404 Not Found for a deleted Dsource but got %d", httpResp.StatusCode)
+		}
+	}
+
+	return nil
+}
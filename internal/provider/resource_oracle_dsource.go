@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 
 	dctapi "github.com/delphix/dct-sdk-go/v25"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +22,20 @@ func resourceOracleDsource() *schema.Resource {
 		CreateContext: resourceOracleDsourceCreate,
 		ReadContext:   resourceOracleDsourceRead,
 		UpdateContext: resourceOracleDsourceUpdate,
-		DeleteContext: resourceOracleDsourceDelete,
+		DeleteContext: resourceDsourceDelete,
+		CustomizeDiff: customdiff.All(
+			customizeDiffDsourceSync,
+			customizeDiffTagsAll,
+			customizeDiffDsourceUpgrade(func(ctx context.Context, d *schema.ResourceDiff, client *dctapi.APIClient) (string, error) {
+				// the Oracle home of a dSource is installed on the environment of its source.
+				sourceId := d.Get("source_id").(string)
+				source, _, err := client.SourcesAPI.GetSourceById(ctx, sourceId).Execute()
+				if err != nil {
+					return "", fmt.Errorf("unable to read source %s to validate upgrade_repository_id: %s", sourceId, err.Error())
+				}
+				return source.GetEnvironmentId(), nil
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -32,6 +47,17 @@ func resourceOracleDsource() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"rollback_on_failure_phases": rollbackOnFailurePhasesSchema(),
+			"delete_dependents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"source_value": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -88,6 +114,7 @@ func resourceOracleDsource() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"ops_pre_sync": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -296,32 +323,18 @@ func resourceOracleDsource() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			"link_now": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
-			"force_full_backup": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
-			"double_sync": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
-			"skip_space_check": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
-			"do_not_resume": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
+			"link_now":          createOnlySchema(schema.TypeBool),
+			"force_full_backup": createOnlySchema(schema.TypeBool),
+			"double_sync":       createOnlySchema(schema.TypeBool),
+			"skip_space_check":  createOnlySchema(schema.TypeBool),
+			"do_not_resume":     createOnlySchema(schema.TypeBool),
 			"files_for_full_backup": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				DiffSuppressFunc: suppressCreateOnlyDiff,
 			},
 			"log_sync_mode": {
 				Type:     schema.TypeString,
@@ -339,8 +352,10 @@ func resourceOracleDsource() *schema.Resource {
 			},
 
 			"non_sys_password": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "password"),
 			},
 
 			"non_sys_vault": {
@@ -349,81 +364,99 @@ func resourceOracleDsource() *schema.Resource {
 			},
 
 			"non_sys_hashicorp_vault_engine": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "hashicorp"),
 			},
 
 			"non_sys_hashicorp_vault_secret_path": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "hashicorp"),
 			},
 			"non_sys_hashicorp_vault_username_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "hashicorp"),
 			},
 			"non_sys_hashicorp_vault_secret_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "hashicorp"),
 			},
 			"non_sys_azure_vault_name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "azure"),
 			},
 			"non_sys_azure_vault_username_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "azure"),
 			},
 			"non_sys_azure_vault_secret_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "azure"),
 			},
 			"non_sys_cyberark_vault_query_string": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("non_sys_", "cyberark"),
 			},
 			"fallback_username": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"fallback_password": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "password"),
 			},
 			"fallback_vault": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"fallback_hashicorp_vault_engine": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "hashicorp"),
 			},
 			"fallback_hashicorp_vault_secret_path": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "hashicorp"),
 			},
 			"fallback_hashicorp_vault_username_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "hashicorp"),
 			},
 			"fallback_hashicorp_vault_secret_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "hashicorp"),
 			},
 			"fallback_azure_vault_name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "azure"),
 			},
 			"fallback_azure_vault_username_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "azure"),
 			},
 			"fallback_azure_vault_secret_key": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "azure"),
 			},
 			"fallback_cyberark_vault_query_string": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: conflictingCredentialKeys("fallback_", "cyberark"),
 			},
 			"ops_pre_log_sync": {
 				Type:     schema.TypeList,
@@ -505,11 +538,80 @@ func resourceOracleDsource() *schema.Resource {
 					},
 				},
 			},
+			"attach_source_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"upgrade_repository_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"upgrade_environment_user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attach_username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attach_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"attach_environment_user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sync_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sync_trigger_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"force_full_backup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"double_sync": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"skip_space_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"files_for_full_backup": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
 			// Output
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_sync_snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"database_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -540,10 +642,12 @@ func resourceOracleDsource() *schema.Resource {
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"is_detached": {
 				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"engine_id": {
@@ -586,23 +690,12 @@ func resourceOracleDsource() *schema.Resource {
 				Type:     schema.TypeInt,
 				Default:  0,
 				Optional: true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if old != new {
-						tflog.Info(context.Background(), "updating wait_time is not allowed. plan changes are suppressed")
-					}
-					return d.Id() != ""
-				},
 			},
+			"snapshot_wait_failure_action": snapshotWaitFailureActionSchema(),
 			"skip_wait_for_snapshot_creation": {
 				Type:     schema.TypeBool,
 				Default:  false,
 				Optional: true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if old != new {
-						tflog.Info(context.Background(), "updating skip_wait_for_snapshot_creation is not allowed. plan changes are suppressed")
-					}
-					return d.Id() != ""
-				},
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -635,8 +728,8 @@ func resourceOracleDsourceCreate(ctx context.Context, d *schema.ResourceData, me
 	if v, has_v := d.GetOkExists("make_current_account_owner"); has_v {
 		oracleDSourceLinkSourceParameters.SetMakeCurrentAccountOwner(v.(bool))
 	}
-	if v, has_v := d.GetOk("tags"); has_v {
-		oracleDSourceLinkSourceParameters.SetTags(toTagArray(v))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		oracleDSourceLinkSourceParameters.SetTags(tags)
 	}
 	if v, has_v := d.GetOk("ops_pre_sync"); has_v {
 		oracleDSourceLinkSourceParameters.SetOpsPreSync(toSourceOperationArray(v))
@@ -788,47 +881,24 @@ func resourceOracleDsourceCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(apiRes.GetDsourceId())
 
-	job_res, job_err := PollJobStatus(apiRes.Job.GetId(), ctx, client)
-	if job_err != "" {
-		tflog.Error(ctx, DLPX+ERROR+"Job Polling failed but continuing with dSource creation. Error: "+job_err)
+	// no snapshot is expected when the initial load is deferred.
+	raw := d.GetRawConfig().GetAttr("link_now")
+	snapshotDiags := linkDsourceAndWait(ctx, d, meta, apiRes.Job, resourceOracleDsourceRead, raw.IsNull() || raw.True())
+	if snapshotDiags.HasError() {
+		return snapshotDiags
 	}
 
-	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_res)
-
-	rollback_on_failure := d.Get("rollback_on_failure").(bool)
-
-	if job_res == Failed || job_res == Canceled || job_res == Abandoned {
-		tflog.Error(ctx, DLPX+ERROR+"Job "+job_res+" "+apiRes.Job.GetId()+"!")
-		if rollback_on_failure {
-			if job_res == Failed {
-				res := isSnapSyncFailure(apiRes.Job.GetId(), ctx, client)
-				if res {
-					deleteDiags := resourceOracleDsourceDelete(ctx, d, meta)
-					if deleteDiags.HasError() {
-						return deleteDiags
-					}
-					d.SetId("")
-				}
-			}
-		} else {
-			readDiags := resourceOracleDsourceRead(ctx, d, meta)
-
-			if readDiags.HasError() {
-				return readDiags
-			}
-		}
-		return diag.Errorf("[NOT OK] Job %s %s with error %s", apiRes.Job.GetId(), job_res, job_err)
+	if diags := applyDsourceLifecycleOnCreate(ctx, d, client); diags != nil {
+		return diags
 	}
 
-	PollSnapshotStatus(d, ctx, client)
-
 	readDiags := resourceOracleDsourceRead(ctx, d, meta)
 
 	if readDiags.HasError() {
 		return readDiags
 	}
 
-	return diags
+	return append(diags, snapshotDiags...)
 }
 
 func resourceOracleDsourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -933,6 +1003,11 @@ func resourceOracleDsourceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if strings.Contains(k, "ops_post_sync") {
 			k = "ops_post_sync"
 		}
+		if strings.Contains(k, "sync_trigger_options") {
+			k = "sync_trigger_options"
+		} else if strings.Contains(k, "files_for_full_backup") {
+			k = "files_for_full_backup"
+		}
 		if d.HasChange(k) {
 			tflog.Debug(ctx, "changed keys"+k)
 			changedKeys = append(changedKeys, k)
@@ -1029,81 +1104,116 @@ func resourceOracleDsourceUpdate(ctx context.Context, d *schema.ResourceData, me
 		updateOracleDsource.SetHooks(*ndsh)
 	}
 
-	res, httpRes, err := client.DSourcesAPI.UpdateOracleDsourceById(ctx, dsourceId).UpdateOracleDsourceParameters(*updateOracleDsource).Execute()
+	if hasDsourceParameterChanges(changedKeys) {
+		res, httpRes, err := client.DSourcesAPI.UpdateOracleDsourceById(ctx, dsourceId).UpdateOracleDsourceParameters(*updateOracleDsource).Execute()
 
-	if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
-		// revert and set the old value to the changed keys
-		revertChanges(d, changedKeys)
-		return diags
-	}
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			// revert and set the old value to the changed keys
+			revertChanges(d, changedKeys)
+			return diags
+		}
 
-	job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
-	if job_err != "" {
-		tflog.Warn(ctx, DLPX+WARN+"Dsource Update Job Polling failed but continuing with update. Error: "+job_err)
-	}
-	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
-	if isJobTerminalFailure(job_status) {
-		return diag.Errorf("[NOT OK] Dsource-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
-	}
-
-	if d.HasChanges(
-		"tags",
-	) { // tags update
-		tflog.Debug(ctx, "updating tags")
-		if d.HasChange("tags") {
-			// delete old tag
-			tflog.Debug(ctx, "deleting old tags")
-			oldTag, newTag := d.GetChange("tags")
-			if len(toTagArray(oldTag)) != 0 {
-				tflog.Debug(ctx, "tag to be deleted: "+toTagArray(oldTag)[0].GetKey()+" "+toTagArray(oldTag)[0].GetValue())
-				deleteTag := *dctapi.NewDeleteTag()
-				tagDelResp, tagDelErr := client.DSourcesAPI.DeleteTagsDsource(ctx, dsourceId).DeleteTag(deleteTag).Execute()
-				if diags := apiErrorResponseHelper(ctx, nil, tagDelResp, tagDelErr); diags != nil {
-					revertChanges(d, changedKeys)
-					updateFailure = true
-				}
-			}
-			// create tag
-			if len(toTagArray(newTag)) != 0 {
-				tflog.Info(ctx, "creating new tags")
-				_, httpResp, tagCrtErr := client.DSourcesAPI.CreateTagsDsource(ctx, dsourceId).TagsRequest(*dctapi.NewTagsRequest(toTagArray(newTag))).Execute()
-				if diags := apiErrorResponseHelper(ctx, nil, httpResp, tagCrtErr); diags != nil {
-					revertChanges(d, changedKeys)
-					return diags
-				}
-			}
+		job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
+		if job_err != "" {
+			tflog.Warn(ctx, DLPX+WARN+"Dsource Update Job Polling failed but continuing with update. Error: "+job_err)
+		}
+		tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+		if isJobTerminalFailure(job_status) {
+			return diag.Errorf("[NOT OK] Dsource-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
 		}
 	}
 
-	return diags
-}
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectDsource); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
 
-func resourceOracleDsourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*apiClient).client
+	if d.HasChange("upgrade_repository_id") {
+		if diags := upgradeOracleDsource(ctx, d, client); diags != nil {
+			revertChanges(d, []string{"upgrade_repository_id", "upgrade_environment_user_id"})
+			return diags
+		}
+	}
 
-	dsourceId := d.Id()
+	if d.HasChanges("enabled", "is_detached", "attach_source_id") {
+		attach := func(sourceId string) diag.Diagnostics {
+			return attachOracleDsource(ctx, d, client, sourceId)
+		}
+		if diags := updateDsourceLifecycle(ctx, d, client, attach); diags != nil {
+			revertChanges(d, []string{"enabled", "is_detached", "attach_source_id"})
+			return diags
+		}
+	}
 
-	deleteDsourceParams := dctapi.NewDeleteDSourceRequest(dsourceId)
-	deleteDsourceParams.SetForce(false)
+	if d.HasChange("sync_trigger") {
+		if diags := syncDsource(ctx, client, dsourceId, toOracleDsourceSnapshotParameters(d.Get("sync_trigger_options"))); diags != nil {
+			old, _ := d.GetChange("sync_trigger")
+			d.Set("sync_trigger", old)
+			revertChanges(d, []string{"last_sync_snapshot_id", "last_sync_snapshot_timestamp", "last_sync_snapshot_timeflow_id"})
+			return diags
+		}
+		if diags := setLatestDsourceSnapshot(ctx, d, client); diags != nil {
+			return diags
+		}
+	}
 
-	res, httpRes, err := client.DSourcesAPI.DeleteDsource(ctx).DeleteDSourceRequest(*deleteDsourceParams).Execute()
+	return diags
+}
 
-	if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+// upgradeOracleDsource points the Oracle dSource at the Oracle home in upgrade_repository_id.
+func upgradeOracleDsource(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient) diag.Diagnostics {
+	repositoryId := d.Get("upgrade_repository_id").(string)
+	if repositoryId == "" {
+		tflog.Info(ctx, DLPX+INFO+"upgrade_repository_id was removed, the dSource keeps its current repository.")
+		return nil
+	}
+	tflog.Info(ctx, DLPX+INFO+"Upgrade dSource "+d.Id()+" to repository "+repositoryId)
+	upgradeParams := dctapi.NewUpgradeOracleDsourceParameters(repositoryId)
+	if v, has_v := d.GetOk("upgrade_environment_user_id"); has_v {
+		upgradeParams.SetEnvironmentUserId(v.(string))
+	}
+	apiRes, httpRes, err := client.DSourcesAPI.UpgradeOracleDsource(ctx, d.Id()).UpgradeOracleDsourceParameters(*upgradeParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
 		return diags
 	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource upgrade")
+}
 
-	job_status, job_err := PollJobStatus(res.GetId(), ctx, client)
-	if job_err != "" {
-		tflog.Warn(ctx, DLPX+WARN+"Job Polling failed but continuing with deletion. Error :"+job_err)
+// attachOracleDsource attaches a detached Oracle dSource to the given source.
+func attachOracleDsource(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient, sourceId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Attach dSource "+d.Id()+" to source "+sourceId)
+	attachParams := dctapi.NewOracleAttachDSourceParameters(sourceId)
+	if v, has_v := d.GetOk("attach_username"); has_v {
+		attachParams.SetUsername(v.(string))
 	}
-	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
-	if isJobTerminalFailure(job_status) {
-		return diag.Errorf("[NOT OK] dSource-Delete %s. JobId: %s / Error: %s", job_status, res.GetId(), job_err)
+	if v, has_v := d.GetOk("attach_password"); has_v {
+		attachParams.SetPassword(v.(string))
 	}
+	if v, has_v := d.GetOk("attach_environment_user_id"); has_v {
+		attachParams.SetEnvironmentUserId(v.(string))
+	}
+	apiRes, httpRes, err := client.DSourcesAPI.AttachOracleDsource(ctx, d.Id()).OracleAttachDSourceParameters(*attachParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource attach")
+}
 
-	_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
-		return client.DSourcesAPI.GetDsourceById(ctx, dsourceId).Execute()
-	})
-
-	return diags
+func toOracleDsourceSnapshotParameters(options interface{}) *dctapi.DSourceSnapshotParameters {
+	snapshotParams := dctapi.NewDSourceSnapshotParameters()
+	for _, item := range options.([]interface{}) {
+		if item == nil {
+			continue
+		}
+		item_map := item.(map[string]interface{})
+		snapshotParams.SetForceFullBackup(item_map["force_full_backup"].(bool))
+		snapshotParams.SetDoubleSync(item_map["double_sync"].(bool))
+		snapshotParams.SetSkipSpaceCheck(item_map["skip_space_check"].(bool))
+		if files := toIntArray(item_map["files_for_full_backup"]); len(files) != 0 {
+			snapshotParams.SetFilesForFullBackup(files)
+		}
+	}
+	return snapshotParams
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -50,6 +51,36 @@ func TestOracleDsource_create_positive(t *testing.T) {
 	})
 }
 
+func TestOracleDsource_sync_trigger(t *testing.T) {
+	sourcevalue := os.Getenv("ORACLE_DSOURCE_SOURCE_VALUE")
+	groupId := os.Getenv("ORACLE_DSOURCE_GROUP_ID")
+	name := os.Getenv("ORACLE_DSOURCE_NAME")
+	var snapshotId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testOracleDsourcePreCheck(t, sourcevalue, groupId, name)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testDsourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testOracleDsourceSyncTrigger(name, sourcevalue, groupId, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testOracleDsourceExists("delphix_oracle_dsource.test_oracle_dsource", sourcevalue),
+					testOracleDsourceLastSyncSnapshot("delphix_oracle_dsource.test_oracle_dsource", &snapshotId, false)),
+			},
+			{
+				// a sync_trigger change on the existing dSource takes a new snapshot
+				Config: testOracleDsourceSyncTrigger(name, sourcevalue, groupId, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("delphix_oracle_dsource.test_oracle_dsource", "sync_trigger", "2"),
+					testOracleDsourceLastSyncSnapshot("delphix_oracle_dsource.test_oracle_dsource", &snapshotId, true)),
+			},
+		},
+	})
+}
+
 func testOracleDsourcePreCheck(t *testing.T, sourceId string, groupId string, name string) {
 	testAccPreCheck(t)
 	if sourceId == "" {
@@ -73,6 +104,41 @@ resource "delphix_oracle_dsource" "test_oracle_dsource" {
 	`, name, sourceValue, groupId)
 }
 
+func testOracleDsourceSyncTrigger(name string, sourceValue string, groupId string, syncTrigger string) string {
+	return fmt.Sprintf(`
+resource "delphix_oracle_dsource" "test_oracle_dsource" {
+  name                       = "%s"
+  source_value               = "%s"
+  group_id                   = "%s"
+  sync_trigger               = "%s"
+  sync_trigger_options {
+    double_sync = true
+  }
+}
+	`, name, sourceValue, groupId, syncTrigger)
+}
+
+// testOracleDsourceLastSyncSnapshot checks that last_sync_snapshot_id is set and, with changed, that it is
+// not the snapshot ID seen by the previous step.
+func testOracleDsourceLastSyncSnapshot(n string, snapshotId *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		lastSyncSnapshotId := rs.Primary.Attributes["last_sync_snapshot_id"]
+		if lastSyncSnapshotId == "" {
+			return fmt.Errorf("No last_sync_snapshot_id set")
+		}
+		if changed && lastSyncSnapshotId == *snapshotId {
+			return fmt.Errorf("last_sync_snapshot_id %s did not change after the sync", lastSyncSnapshotId)
+		}
+		*snapshotId = lastSyncSnapshotId
+		return nil
+	}
+}
+
 func testOracleDsourceUpdateNegative(name string, sourceValue string, description string) string {
 	return fmt.Sprintf(`
 resource "delphix_oracle_dsource" "test_oracle_dsource" {
@@ -133,3 +199,24 @@ func testOracleDsourceDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestSuppressCreateOnlyDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceOracleDsource().Schema, map[string]interface{}{
+		"link_now":              true,
+		"files_for_full_backup": []interface{}{1},
+	})
+	if suppressCreateOnlyDiff("link_now", "", "true", d) {
+		t.Errorf("expected the diff of a new dSource not to be suppressed")
+	}
+
+	d.SetId("dsource")
+	if !suppressCreateOnlyDiff("link_now", "", "true", d) {
+		t.Errorf("expected the diff to be suppressed when the state has no value")
+	}
+	if suppressCreateOnlyDiff("link_now", "false", "true", d) {
+		t.Errorf("expected the diff to be kept when the state has a value")
+	}
+	if !suppressCreateOnlyDiff("files_for_full_backup.0", "", "1", d) {
+		t.Errorf("expected the list diff to be suppressed when the state has no value")
+	}
+}
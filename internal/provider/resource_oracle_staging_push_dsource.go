@@ -0,0 +1,489 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceOracleStagingPushDsource() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource for Oracle staging push dSource creation.",
+
+		CreateContext: resourceOracleStagingPushDsourceCreate,
+		ReadContext:   resourceOracleStagingPushDsourceRead,
+		UpdateContext: resourceOracleStagingPushDsourceUpdate,
+		DeleteContext: resourceDsourceDelete,
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rollback_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rollback_on_failure_phases": rollbackOnFailurePhasesSchema(),
+			"delete_dependents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if old != new {
+						tflog.Info(context.Background(), "updating group_id is not allowed. plan changes are suppressed")
+					}
+					return d.Id() != ""
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_sync_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"make_current_account_owner": createOnlySchema(schema.TypeBool),
+			"staging_environment": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"staging_environment_user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"database_unique_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sid": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"container_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NON_CDB",
+				ValidateFunc: validation.StringInSlice([]string{"NON_CDB", "ROOT_CDB", "PDB"}, false),
+			},
+			"cdb_database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mount_base": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"allow_auto_staging_restart_on_host_reboot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"physical_standby": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"validate_by_opening_db_in_read_only_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags":             dsourceTagsSchema(),
+			"tags_all":         tagsAllSchema(),
+			"ops_pre_sync":     dsourceOperationsSchema(),
+			"ops_post_sync":    dsourceOperationsSchema(),
+			"ops_pre_log_sync": dsourceOperationsSchema(),
+			// Output
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_detached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync_snapshot_timeflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_time": {
+				Type:     schema.TypeInt,
+				Default:  0,
+				Optional: true,
+			},
+			"snapshot_wait_failure_action": snapshotWaitFailureActionSchema(),
+			"skip_wait_for_snapshot_creation": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceOracleStagingPushDsourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+
+	containerType := d.Get("container_type").(string)
+	if _, has_v := d.GetOk("cdb_database_name"); !has_v && containerType == "PDB" {
+		return diag.Errorf("cdb_database_name is required for container_type = 'PDB'")
+	}
+
+	stagingPushParameters := dctapi.NewOracleStagingPushDSourceLinkSourceParameters(
+		d.Get("staging_environment").(string),
+		d.Get("staging_environment_user").(string),
+		d.Get("repository").(string),
+		d.Get("database_name").(string),
+	)
+
+	stagingPushParameters.SetContainerType(containerType)
+	if v, has_v := d.GetOk("name"); has_v {
+		stagingPushParameters.SetName(v.(string))
+	}
+	if v, has_v := d.GetOk("group_id"); has_v {
+		stagingPushParameters.SetGroupId(v.(string))
+	}
+	if v, has_v := d.GetOk("description"); has_v {
+		stagingPushParameters.SetDescription(v.(string))
+	}
+	if v, has_v := d.GetOkExists("log_sync_enabled"); has_v {
+		stagingPushParameters.SetLogSyncEnabled(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("make_current_account_owner"); has_v {
+		stagingPushParameters.SetMakeCurrentAccountOwner(v.(bool))
+	}
+	if v, has_v := d.GetOk("database_unique_name"); has_v {
+		stagingPushParameters.SetDatabaseUniqueName(v.(string))
+	}
+	if v, has_v := d.GetOk("sid"); has_v {
+		stagingPushParameters.SetSid(v.(string))
+	}
+	if v, has_v := d.GetOk("cdb_database_name"); has_v {
+		stagingPushParameters.SetCdbDatabaseName(v.(string))
+	}
+	if v, has_v := d.GetOk("mount_base"); has_v {
+		stagingPushParameters.SetMountBase(v.(string))
+	}
+	if v, has_v := d.GetOkExists("allow_auto_staging_restart_on_host_reboot"); has_v {
+		stagingPushParameters.SetAllowAutoStagingRestartOnHostReboot(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("physical_standby"); has_v {
+		stagingPushParameters.SetPhysicalStandby(v.(bool))
+	}
+	if v, has_v := d.GetOkExists("validate_by_opening_db_in_read_only_mode"); has_v {
+		stagingPushParameters.SetValidateByOpeningDbInReadOnlyMode(v.(bool))
+	}
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		stagingPushParameters.SetTags(tags)
+	}
+	if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+		stagingPushParameters.SetOpsPreSync(toSourceOperationArray(v))
+	}
+	if v, has_v := d.GetOk("ops_post_sync"); has_v {
+		stagingPushParameters.SetOpsPostSync(toSourceOperationArray(v))
+	}
+	if v, has_v := d.GetOk("ops_pre_log_sync"); has_v {
+		stagingPushParameters.SetOpsPreLogSync(toSourceOperationArray(v))
+	}
+
+	apiRes, httpRes, err := client.DSourcesAPI.LinkOracleStagingPushDatabase(ctx).OracleStagingPushDSourceLinkSourceParameters(*stagingPushParameters).Execute()
+
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+
+	d.SetId(apiRes.GetDsourceId())
+
+	snapshotDiags := linkDsourceAndWait(ctx, d, meta, apiRes.Job, resourceOracleStagingPushDsourceRead, true)
+	if snapshotDiags.HasError() {
+		return snapshotDiags
+	}
+
+	readDiags := resourceOracleStagingPushDsourceRead(ctx, d, meta)
+
+	if readDiags.HasError() {
+		return readDiags
+	}
+
+	return append(diags, snapshotDiags...)
+}
+
+func resourceOracleStagingPushDsourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	dsource_id := d.Id()
+
+	res, diags := PollForObjectExistence(ctx, func() (interface{}, *http.Response, error) {
+		return client.DSourcesAPI.GetDsourceById(ctx, dsource_id).Execute()
+	})
+
+	if res == nil {
+		tflog.Error(ctx, DLPX+ERROR+"Dsource not found: "+dsource_id+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+
+	if diags != nil {
+		_, diags := PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+			return client.DSourcesAPI.GetDsourceById(ctx, dsource_id).Execute()
+		})
+		// This would imply error in poll for deletion so we just log and exit.
+		if diags != nil {
+			tflog.Error(ctx, DLPX+ERROR+"Error in polling of dSource for deletion.")
+		} else {
+			// diags will be nil in case of successful poll for deletion logic aka 404
+			tflog.Error(ctx, DLPX+ERROR+"Error reading the dSource "+dsource_id+", removing from state.")
+			d.SetId("")
+		}
+
+		return nil
+	}
+
+	result, ok := res.(*dctapi.DSource)
+	if !ok {
+		return diag.Errorf("Error occured in type casting.")
+	}
+
+	_, rollback_on_failure_exists := d.GetOk("rollback_on_failure")
+	if !rollback_on_failure_exists {
+		// its an import or upgrade, set to default value
+		d.Set("rollback_on_failure", false)
+	}
+
+	ops_pre_sync_Raw, _ := d.Get("ops_pre_sync").([]interface{})
+	oldOpsPreSync := toSourceOperationArray(ops_pre_sync_Raw)
+
+	ops_post_sync_Raw, _ := d.Get("ops_post_sync").([]interface{})
+	oldOpsPostSync := toSourceOperationArray(ops_post_sync_Raw)
+
+	ops_pre_log_sync_Raw, _ := d.Get("ops_pre_log_sync").([]interface{})
+	oldOpsPreLogSync := toSourceOperationArray(ops_pre_log_sync_Raw)
+
+	d.Set("id", result.GetId())
+	d.Set("database_type", result.GetDatabaseType())
+	d.Set("name", result.GetName())
+	d.Set("description", result.GetDescription())
+	d.Set("database_version", result.GetDatabaseVersion())
+	d.Set("data_uuid", result.GetDataUuid())
+	d.Set("creation_date", result.GetCreationDate().String())
+	d.Set("group_name", result.GetGroupName())
+	d.Set("enabled", result.GetEnabled())
+	d.Set("is_detached", result.GetIsDetached())
+	d.Set("engine_id", result.GetEngineId())
+	d.Set("source_id", result.GetSourceId())
+	d.Set("status", result.GetStatus())
+	d.Set("engine_name", result.GetEngineName())
+	d.Set("current_timeflow_id", result.GetCurrentTimeflowId())
+	d.Set("log_sync_enabled", result.GetLogsyncEnabled())
+	d.Set("tags", flattenResourceTags(d, meta, result.GetTags()))
+	d.Set("tags_all", flattenTags(result.GetTags()))
+	d.Set("ops_pre_sync", flattenDSourceHooks(result.GetHooks().OpsPreSync, oldOpsPreSync))
+	d.Set("ops_post_sync", flattenDSourceHooks(result.GetHooks().OpsPostSync, oldOpsPostSync))
+	d.Set("ops_pre_log_sync", flattenDSourceHooks(result.GetHooks().OpsPreLogSync, oldOpsPreLogSync))
+	return diags
+}
+
+func resourceOracleStagingPushDsourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	client := meta.(*apiClient).client
+	updateOracleDsource := dctapi.NewUpdateOracleDsourceParameters()
+
+	dsourceId := d.Get("id").(string)
+
+	// get the changed keys
+	changedKeys := make([]string, 0, len(d.State().Attributes))
+	for k := range d.State().Attributes {
+		if strings.Contains(k, "tags") { // this is because the changed keys are of the form tag.0.keydi
+			k = "tags"
+		}
+		if strings.Contains(k, "ops_pre_sync") {
+			k = "ops_pre_sync"
+		}
+		if strings.Contains(k, "ops_post_sync") {
+			k = "ops_post_sync"
+		}
+		if strings.Contains(k, "ops_pre_log_sync") {
+			k = "ops_pre_log_sync"
+		}
+		if d.HasChange(k) {
+			tflog.Debug(ctx, "changed keys"+k)
+			changedKeys = append(changedKeys, k)
+		}
+	}
+
+	var nonUpdatableField []string
+
+	// check if the changed keys are updatable
+	for _, key := range changedKeys {
+		if !updatableOracleStagingPushDsourceKeys[key] {
+			tflog.Debug(ctx, "non updatable field: "+key)
+			nonUpdatableField = append(nonUpdatableField, key)
+		}
+	}
+
+	// if not updatable keys are provided, error out
+	if len(nonUpdatableField) != 0 {
+		revertChanges(d, changedKeys)
+		return diag.Errorf("cannot update options %v. Please refer to provider documentation for updatable params.", nonUpdatableField)
+	}
+
+	// set changed params in the updateOracleDsource
+	if d.HasChange("name") {
+		updateOracleDsource.SetName(d.Get("name").(string))
+	}
+	if d.HasChange("staging_environment_user") {
+		updateOracleDsource.SetEnvironmentUserId(d.Get("staging_environment_user").(string))
+	}
+	if d.HasChange("allow_auto_staging_restart_on_host_reboot") {
+		updateOracleDsource.SetAllowAutoStagingRestartOnHostReboot(d.Get("allow_auto_staging_restart_on_host_reboot").(bool))
+	}
+	if d.HasChange("physical_standby") {
+		updateOracleDsource.SetPhysicalStandby(d.Get("physical_standby").(bool))
+	}
+	if d.HasChange("validate_by_opening_db_in_read_only_mode") {
+		updateOracleDsource.SetValidateByOpeningDbInReadOnlyMode(d.Get("validate_by_opening_db_in_read_only_mode").(bool))
+	}
+
+	// update hooks
+	if d.HasChanges("ops_pre_sync", "ops_post_sync", "ops_pre_log_sync") {
+		ndsh := dctapi.NewDSourceHooks()
+
+		if d.HasChange("ops_pre_sync") {
+			if v, has_v := d.GetOk("ops_pre_sync"); has_v {
+				ndsh.SetOpsPreSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPreSync([]dctapi.Hook{})
+			}
+		}
+
+		if d.HasChange("ops_post_sync") {
+			if v, has_v := d.GetOk("ops_post_sync"); has_v {
+				ndsh.SetOpsPostSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPostSync([]dctapi.Hook{})
+			}
+		}
+
+		if d.HasChange("ops_pre_log_sync") {
+			if v, has_v := d.GetOk("ops_pre_log_sync"); has_v {
+				ndsh.SetOpsPreLogSync(toHookArray(v))
+			} else {
+				ndsh.SetOpsPreLogSync([]dctapi.Hook{})
+			}
+		}
+
+		updateOracleDsource.SetHooks(*ndsh)
+	}
+
+	if hasDsourceParameterChanges(changedKeys) {
+		res, httpRes, err := client.DSourcesAPI.UpdateOracleDsourceById(ctx, dsourceId).UpdateOracleDsourceParameters(*updateOracleDsource).Execute()
+
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			// revert and set the old value to the changed keys
+			revertChanges(d, changedKeys)
+			return diags
+		}
+
+		job_status, job_err := PollJobStatus(res.Job.GetId(), ctx, client)
+		if job_err != "" {
+			tflog.Warn(ctx, DLPX+WARN+"Dsource Update Job Polling failed but continuing with update. Error: "+job_err)
+		}
+		tflog.Info(ctx, DLPX+INFO+"Job result is "+job_status)
+		if isJobTerminalFailure(job_status) {
+			return diag.Errorf("[NOT OK] Dsource-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectDsource); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
+		}
+	}
+
+	return diags
+}
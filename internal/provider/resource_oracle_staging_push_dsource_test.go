@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestOracleStagingPushDsource_create_positive(t *testing.T) {
+	name := os.Getenv("ORACLE_STAGING_PUSH_DSOURCE_NAME")
+	stagingEnv := os.Getenv("ORACLE_STAGING_PUSH_DSOURCE_ENVIRONMENT")
+	stagingEnvUser := os.Getenv("ORACLE_STAGING_PUSH_DSOURCE_ENVIRONMENT_USER")
+	repository := os.Getenv("ORACLE_STAGING_PUSH_DSOURCE_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testOracleStagingPushDsourcePreCheck(t, name, stagingEnv, stagingEnvUser, repository)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testDsourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testOracleStagingPushDsourceBasic(name, stagingEnv, stagingEnvUser, repository, "stgpush"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("delphix_oracle_staging_push_dsource.test_oracle_staging_push_dsource", "id"),
+					resource.TestCheckResourceAttr("delphix_oracle_staging_push_dsource.test_oracle_staging_push_dsource", "container_type", "NON_CDB")),
+			},
+			{
+				// positive update test case
+				Config: testOracleStagingPushDsourceBasic("update_name", stagingEnv, stagingEnvUser, repository, "stgpush"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("delphix_oracle_staging_push_dsource.test_oracle_staging_push_dsource", "name", "update_name")),
+			},
+			{
+				// negative update test case, the database name is not updatable
+				Config:      testOracleStagingPushDsourceBasic("update_name", stagingEnv, stagingEnvUser, repository, "other"),
+				ExpectError: regexp.MustCompile(`.*`),
+			},
+		},
+	})
+}
+
+func testOracleStagingPushDsourcePreCheck(t *testing.T, name string, stagingEnv string, stagingEnvUser string, repository string) {
+	testAccPreCheck(t)
+	if name == "" {
+		t.Fatal("ORACLE_STAGING_PUSH_DSOURCE_NAME must be set for env acceptance tests")
+	}
+	if stagingEnv == "" {
+		t.Fatal("ORACLE_STAGING_PUSH_DSOURCE_ENVIRONMENT must be set for env acceptance tests")
+	}
+	if stagingEnvUser == "" {
+		t.Fatal("ORACLE_STAGING_PUSH_DSOURCE_ENVIRONMENT_USER must be set for env acceptance tests")
+	}
+	if repository == "" {
+		t.Fatal("ORACLE_STAGING_PUSH_DSOURCE_REPOSITORY must be set for env acceptance tests")
+	}
+}
+
+func testOracleStagingPushDsourceBasic(name string, stagingEnv string, stagingEnvUser string, repository string, databaseName string) string {
+	return fmt.Sprintf(`
+resource "delphix_oracle_staging_push_dsource" "test_oracle_staging_push_dsource" {
+  name                     = "%s"
+  staging_environment      = "%s"
+  staging_environment_user = "%s"
+  repository               = "%s"
+  database_name            = "%s"
+}
+	`, name, stagingEnv, stagingEnvUser, repository, databaseName)
+}
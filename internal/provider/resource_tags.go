@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTags() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for managing the tags of any DCT object.",
+
+		CreateContext: resourceTagsCreate,
+		ReadContext:   resourceTagsRead,
+		UpdateContext: resourceTagsUpdate,
+		DeleteContext: resourceTagsDelete,
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(tagObjectTypeNames(), false),
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags_all": tagsAllSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTagsImport,
+		},
+	}
+}
+
+func resourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	objectType := d.Get("object_type").(string)
+	objectId := d.Get("object_id").(string)
+
+	if diags := reconcileTags(ctx, client, objectType, objectId, []dctapi.Tag{}, tagsWithDefaults(d, meta)); diags != nil {
+		return diags
+	}
+
+	d.SetId(objectType + ":" + objectId)
+	return resourceTagsRead(ctx, d, meta)
+}
+
+func resourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	objectType := d.Get("object_type").(string)
+	objectId := d.Get("object_id").(string)
+
+	ops, ok := tagObjectTypes[objectType]
+	if !ok {
+		return diag.Errorf("tags of %s objects are not supported.", objectType)
+	}
+	tags, httpRes, err := ops.list(ctx, client, objectId)
+	if httpRes != nil && httpRes.StatusCode == 404 {
+		tflog.Error(ctx, DLPX+ERROR+"Tagged "+objectType+" not found: "+objectId+", removing from state. ")
+		d.SetId("")
+		return nil
+	}
+	if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+		return diags
+	}
+
+	// Only the managed tags are kept, so that tags set outside of the resource don't show as a diff.
+	// The tags of tags_all stay managed until they are removed, even when default_tags no longer has
+	// them. Every tag is managed after an import.
+	imported := len(toTagArray(d.Get("tags"))) == 0
+	managed := map[string]bool{}
+	for _, tag := range append(tagsWithDefaults(d, meta), toTagArray(d.Get("tags_all"))...) {
+		managed[tag.GetKey()+"="+tag.GetValue()] = true
+	}
+	current := []dctapi.Tag{}
+	for _, tag := range tags {
+		if imported || managed[tag.GetKey()+"="+tag.GetValue()] {
+			current = append(current, tag)
+		}
+	}
+	d.Set("tags", flattenResourceTags(d, meta, current))
+	d.Set("tags_all", flattenTags(current))
+	return nil
+}
+
+func resourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	objectType := d.Get("object_type").(string)
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, objectType); diags != nil {
+			old, _ := d.GetChange("tags")
+			d.Set("tags", old)
+			return diags
+		}
+	}
+	return resourceTagsRead(ctx, d, meta)
+}
+
+func resourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).client
+	objectType := d.Get("object_type").(string)
+	objectId := d.Get("object_id").(string)
+
+	return reconcileTags(ctx, client, objectType, objectId, toTagArray(d.Get("tags_all")), []dctapi.Tag{})
+}
+
+// resourceTagsImport imports the tags of an object from an ID of the form <object_type>:<object_id>.
+func resourceTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %s, expected <object_type>:<object_id>", d.Id())
+	}
+	if _, ok := tagObjectTypes[parts[0]]; !ok {
+		return nil, fmt.Errorf("tags of %s objects are not supported", parts[0])
+	}
+	d.Set("object_type", parts[0])
+	d.Set("object_id", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
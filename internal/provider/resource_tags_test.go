@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTags_vdb_positive(t *testing.T) {
+	datasource_id := os.Getenv("DATASOURCE_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccVdbPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDctTagsConfig(datasource_id, "qa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDctTagsExist("delphix_tags.vdb", "team", "qa"),
+					resource.TestCheckResourceAttr("delphix_tags.vdb", "tags.#", "2")),
+			},
+			{
+				Config: testAccCheckDctTagsConfig(datasource_id, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDctTagsExist("delphix_tags.vdb", "team", "dev"),
+					testAccCheckDctTagsExist("delphix_tags.vdb", "release", "2026.10"),
+					resource.TestCheckResourceAttr("delphix_tags.vdb", "tags.0.value", "dev")),
+			},
+			{
+				ResourceName:      "delphix_tags.vdb",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDctTagsConfig(datasource_id string, team string) string {
+	return fmt.Sprintf(`
+	resource "delphix_vdb" "new" {
+		auto_select_repository = true
+		source_data_id         = "%s"
+	}
+	resource "delphix_tags" "vdb" {
+		object_type = "vdb"
+		object_id   = delphix_vdb.new.id
+		tags {
+			key   = "team"
+			value = "%s"
+		}
+		tags {
+			key   = "release"
+			value = "2026.10"
+		}
+	}
+	`, datasource_id, team)
+}
+
+func testAccCheckDctTagsExist(n string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient).client
+		tags, _, err := tagObjectTypes[rs.Primary.Attributes["object_type"]].list(context.Background(), client, rs.Primary.Attributes["object_id"])
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			if tag.GetKey() == key && tag.GetValue() == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("tag %s=%s not found on %s", key, value, rs.Primary.Attributes["object_id"])
+	}
+}
+
+func testAccCheckTagsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "delphix_tags" {
+			continue
+		}
+
+		tags, httpResp, _ := tagObjectTypes[rs.Primary.Attributes["object_type"]].list(context.Background(), client, rs.Primary.Attributes["object_id"])
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			continue
+		}
+		for _, tag := range tags {
+			if tag.GetKey() == "release" && tag.GetValue() == "2026.10" {
+				return fmt.Errorf("tag %s=%s still exists on %s", tag.GetKey(), tag.GetValue(), rs.Primary.Attributes["object_id"])
+			}
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	dctapi "github.com/delphix/dct-sdk-go/v25"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,6 +24,7 @@ func resourceVdb() *schema.Resource {
 		ReadContext:   resourceVdbRead,
 		UpdateContext: resourceVdbUpdate,
 		DeleteContext: resourceVdbDelete,
+		CustomizeDiff: customdiff.All(customizeDiffVdbTimestamp, customizeDiffTagsAll),
 
 		Schema: map[string]*schema.Schema{
 			"provision_type": {
@@ -595,6 +597,7 @@ func resourceVdb() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"appdata_source_params": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -937,8 +940,8 @@ func helper_provision_by_snapshot(ctx context.Context, d *schema.ResourceData, m
 	if v, has_v := d.GetOk("post_stop"); has_v {
 		provisionVDBBySnapshotParameters.SetPostStop(toHookArray(v))
 	}
-	if v, has_v := d.GetOk("tags"); has_v {
-		provisionVDBBySnapshotParameters.SetTags(toTagArray(v))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		provisionVDBBySnapshotParameters.SetTags(tags)
 	}
 	if v, has_v := d.GetOk("appdata_source_params"); has_v {
 		appdata_source_params := make(map[string]interface{})
@@ -1185,8 +1188,8 @@ func helper_provision_by_timestamp(ctx context.Context, d *schema.ResourceData,
 	if v, has_v := d.GetOk("post_stop"); has_v {
 		provisionVDBByTimestampParameters.SetPostStop(toHookArray(v))
 	}
-	if v, has_v := d.GetOk("tags"); has_v {
-		provisionVDBByTimestampParameters.SetTags(toTagArray(v))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		provisionVDBByTimestampParameters.SetTags(tags)
 	}
 	if v, has_v := d.GetOk("appdata_source_params"); has_v {
 		appdata_source_params := make(map[string]interface{})
@@ -1418,8 +1421,8 @@ func helper_provision_by_bookmark(ctx context.Context, d *schema.ResourceData, m
 	if v, has_v := d.GetOk("post_stop"); has_v {
 		provisionVDBFromBookmarkParameters.SetPostStop(toHookArray(v))
 	}
-	if v, has_v := d.GetOk("tags"); has_v {
-		provisionVDBFromBookmarkParameters.SetPostStop(toHookArray(v))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		provisionVDBFromBookmarkParameters.SetTags(tags)
 	}
 	if v, has_v := d.GetOk("appdata_source_params"); has_v {
 		appdata_source_params := make(map[string]interface{})
@@ -1597,7 +1600,8 @@ func resourceVdbRead(ctx context.Context, d *schema.ResourceData, meta interface
 		d.Set("database_name", result.GetDatabaseName())
 	}
 
-	d.Set("tags", flattenTags(result.GetTags()))
+	d.Set("tags", flattenResourceTags(d, meta, result.GetTags()))
+	d.Set("tags_all", flattenTags(result.GetTags()))
 	d.Set("vdb_restart", result.GetVdbRestart())
 
 	_, is_provision := d.GetOk("provision_type")
@@ -1920,32 +1924,10 @@ func resourceVdbUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("[NOT OK] VDB-Update %s. JobId: %s / Error: %s", job_status, res.Job.GetId(), job_err)
 	}
 
-	if d.HasChanges(
-		"tags",
-	) { // tags update
-		tflog.Debug(ctx, "updating tags")
-		if d.HasChange("tags") {
-			// delete old tag
-			tflog.Debug(ctx, "deleting old tags")
-			oldTag, newTag := d.GetChange("tags")
-			if len(toTagArray(oldTag)) != 0 {
-				tflog.Debug(ctx, "tag to be deleted: "+toTagArray(oldTag)[0].GetKey()+" "+toTagArray(oldTag)[0].GetValue())
-				deleteTag := *dctapi.NewDeleteTag()
-				tagDelResp, tagDelErr := client.VDBsAPI.DeleteVdbTags(ctx, vdbId).DeleteTag(deleteTag).Execute()
-				if diags := apiErrorResponseHelper(ctx, nil, tagDelResp, tagDelErr); diags != nil {
-					revertChanges(d, changedKeys)
-					updateFailure = true
-				}
-			}
-			// create tag
-			if len(toTagArray(newTag)) != 0 {
-				tflog.Info(ctx, "creating new tags")
-				_, httpResp, tagCrtErr := client.VDBsAPI.CreateVdbTags(ctx, vdbId).TagsRequest(*dctapi.NewTagsRequest(toTagArray(newTag))).Execute()
-				if diags := apiErrorResponseHelper(ctx, nil, httpResp, tagCrtErr); diags != nil {
-					revertChanges(d, changedKeys)
-					return diags
-				}
-			}
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectVdb); diags != nil {
+			revertChanges(d, changedKeys)
+			return diags
 		}
 	}
 	if destructiveUpdate {
@@ -18,6 +18,7 @@ func resourceVdbGroup() *schema.Resource {
 		ReadContext:   resourceVdbGroupRead,
 		UpdateContext: resourceVdbGroupUpdate,
 		DeleteContext: resourceVdbGroupDelete,
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -35,6 +36,23 @@ func resourceVdbGroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -47,6 +65,9 @@ func resourceVdbGroupCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	vdbGroupCreateReq := *dctapi.NewCreateVDBGroupRequest(d.Get("name").(string))
 	vdbGroupCreateReq.SetVdbIds(toStringArray(d.Get("vdb_ids")))
+	if tags := tagsWithDefaults(d, meta); len(tags) != 0 {
+		vdbGroupCreateReq.SetTags(tags)
+	}
 	apiRes, httpRes, err := client.VDBGroupsAPI.CreateVdbGroup(ctx).CreateVDBGroupRequest(vdbGroupCreateReq).Execute()
 
 	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
@@ -80,12 +101,26 @@ func resourceVdbGroupRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("name", apiRes.GetName())
 	d.Set("vdb_ids", apiRes.GetVdbIds())
+	d.Set("tags", flattenResourceTags(d, meta, apiRes.GetTags()))
+	d.Set("tags_all", flattenTags(apiRes.GetTags()))
 	return diags
 }
 
 func resourceVdbGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	return diag.Errorf("not implemented")
+	if d.HasChanges("name", "vdb_ids") {
+		return diag.Errorf("not implemented")
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if diags := updateResourceTags(ctx, d, meta, TagObjectVdbGroup); diags != nil {
+			old, _ := d.GetChange("tags")
+			d.Set("tags", old)
+			return diags
+		}
+	}
+
+	return resourceVdbGroupRead(ctx, d, meta)
 }
 
 func resourceVdbGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
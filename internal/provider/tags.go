@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Object types whose tags are managed through the DCT tag APIs.
+const (
+	TagObjectBookmark    = "bookmark"
+	TagObjectDsource     = "dsource"
+	TagObjectEngine      = "engine"
+	TagObjectEnvironment = "environment"
+	TagObjectSnapshot    = "snapshot"
+	TagObjectSource      = "source"
+	TagObjectVdb         = "vdb"
+	TagObjectVdbGroup    = "vdb_group"
+)
+
+// tagOperations lists, adds and removes the tags of one type of DCT object.
+type tagOperations struct {
+	list   func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error)
+	create func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error)
+	delete func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error)
+}
+
+var tagObjectTypes = map[string]tagOperations{
+	TagObjectBookmark: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.BookmarksAPI.GetBookmarkTags(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.BookmarksAPI.CreateBookmarkTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.BookmarksAPI.DeleteBookmarkTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectDsource: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.DSourcesAPI.GetTagsDsource(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.DSourcesAPI.CreateTagsDsource(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.DSourcesAPI.DeleteTagsDsource(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectEngine: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.ManagementAPI.GetEngineTags(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.ManagementAPI.CreateEngineTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.ManagementAPI.DeleteEngineTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectEnvironment: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.EnvironmentsAPI.GetEnvironmentTags(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.EnvironmentsAPI.CreateEnvironmentTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.EnvironmentsAPI.DeleteEnvironmentTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectSnapshot: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.SnapshotsAPI.GetSnapshotTags(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.SnapshotsAPI.CreateSnapshotTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.SnapshotsAPI.DeleteSnapshotTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectSource: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.SourcesAPI.GetSourceTags(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.SourcesAPI.CreateSourceTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.SourcesAPI.DeleteSourceTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectVdb: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.VDBsAPI.GetTagsVdb(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.VDBsAPI.CreateVdbTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.VDBsAPI.DeleteVdbTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+	TagObjectVdbGroup: {
+		list: func(ctx context.Context, client *dctapi.APIClient, id string) ([]dctapi.Tag, *http.Response, error) {
+			res, httpRes, err := client.VDBGroupsAPI.GetVdbGroupTags(ctx, id).Execute()
+			return res.GetTags(), httpRes, err
+		},
+		create: func(ctx context.Context, client *dctapi.APIClient, id string, tags dctapi.TagsRequest) (*http.Response, error) {
+			_, httpRes, err := client.VDBGroupsAPI.CreateVdbGroupTags(ctx, id).TagsRequest(tags).Execute()
+			return httpRes, err
+		},
+		delete: func(ctx context.Context, client *dctapi.APIClient, id string, deleteTag dctapi.DeleteTag) (*http.Response, error) {
+			return client.VDBGroupsAPI.DeleteVdbGroupTags(ctx, id).DeleteTag(deleteTag).Execute()
+		},
+	},
+}
+
+// tagObjectTypeNames returns the sorted object types supported by the tag APIs.
+func tagObjectTypeNames() []string {
+	names := make([]string, 0, len(tagObjectTypes))
+	for k := range tagObjectTypes {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// diffTags returns the tags to add and the tags to remove to go from the old tags to the new tags.
+// A tag whose value changed is removed with its old value and added with its new value.
+func diffTags(oldTags []dctapi.Tag, newTags []dctapi.Tag) ([]dctapi.Tag, []dctapi.Tag) {
+	oldSet := map[string]bool{}
+	for _, tag := range oldTags {
+		oldSet[tag.GetKey()+"="+tag.GetValue()] = true
+	}
+	newSet := map[string]bool{}
+	for _, tag := range newTags {
+		newSet[tag.GetKey()+"="+tag.GetValue()] = true
+	}
+
+	added := []dctapi.Tag{}
+	for _, tag := range newTags {
+		if !oldSet[tag.GetKey()+"="+tag.GetValue()] {
+			added = append(added, tag)
+		}
+	}
+	removed := []dctapi.Tag{}
+	for _, tag := range oldTags {
+		if !newSet[tag.GetKey()+"="+tag.GetValue()] {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
+
+// reconcileTags removes the tags of the object that are only in oldTags and adds the ones only in
+// newTags. Tags in both are left untouched.
+func reconcileTags(ctx context.Context, client *dctapi.APIClient, objectType string, id string, oldTags []dctapi.Tag, newTags []dctapi.Tag) diag.Diagnostics {
+	ops, ok := tagObjectTypes[objectType]
+	if !ok {
+		return diag.Errorf("tags of %s objects are not supported.", objectType)
+	}
+	added, removed := diffTags(oldTags, newTags)
+	if len(removed) != 0 {
+		tflog.Info(ctx, DLPX+INFO+"Removing "+strconv.Itoa(len(removed))+" tags from "+objectType+" "+id)
+		deleteTag := *dctapi.NewDeleteTag()
+		deleteTag.SetTags(removed)
+		httpRes, err := ops.delete(ctx, client, id, deleteTag)
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			return diags
+		}
+	}
+	if len(added) != 0 {
+		tflog.Info(ctx, DLPX+INFO+"Adding "+strconv.Itoa(len(added))+" tags to "+objectType+" "+id)
+		httpRes, err := ops.create(ctx, client, id, *dctapi.NewTagsRequest(added))
+		if diags := apiErrorResponseHelper(ctx, nil, httpRes, err); diags != nil {
+			return diags
+		}
+	}
+	return nil
+}
+
+// mergeDefaultTags returns the default tags followed by the tags. A tag overrides the default tag
+// with the same key.
+func mergeDefaultTags(defaultTags []dctapi.Tag, tags []dctapi.Tag) []dctapi.Tag {
+	keys := map[string]bool{}
+	for _, tag := range tags {
+		keys[tag.GetKey()] = true
+	}
+	merged := []dctapi.Tag{}
+	for _, tag := range defaultTags {
+		if !keys[tag.GetKey()] {
+			merged = append(merged, tag)
+		}
+	}
+	return append(merged, tags...)
+}
+
+// tagsWithDefaults returns the configured tags of the resource merged with the provider default_tags,
+// to be set when the object is created.
+func tagsWithDefaults(d *schema.ResourceData, meta interface{}) []dctapi.Tag {
+	return mergeDefaultTags(meta.(*apiClient).defaultTags, toTagArray(d.Get("tags")))
+}
+
+// tagsAllSchema returns the schema of tags_all, the tags of the object including the provider default_tags.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffTagsAll plans tags_all as the configured tags merged with the provider default_tags, so
+// that a change of default_tags is planned, and applied, as an update of the tagged object.
+func customizeDiffTagsAll(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	tagsAll := mergeDefaultTags(meta.(*apiClient).defaultTags, toTagArray(d.Get("tags")))
+	added, removed := diffTags(toTagArray(d.Get("tags_all")), tagsAll)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	return d.SetNew("tags_all", flattenTags(tagsAll))
+}
+
+// flattenResourceTags flattens the tags of the object without the provider default_tags, unless the
+// resource configures a tag with the same key. The default tags are kept in tags_all.
+func flattenResourceTags(d *schema.ResourceData, meta interface{}, tags []dctapi.Tag) []interface{} {
+	configured := map[string]bool{}
+	for _, tag := range toTagArray(d.Get("tags")) {
+		configured[tag.GetKey()] = true
+	}
+	defaults := map[string]bool{}
+	for _, tag := range meta.(*apiClient).defaultTags {
+		defaults[tag.GetKey()+"="+tag.GetValue()] = true
+	}
+	filtered := []dctapi.Tag{}
+	for _, tag := range tags {
+		if defaults[tag.GetKey()+"="+tag.GetValue()] && !configured[tag.GetKey()] {
+			continue
+		}
+		filtered = append(filtered, tag)
+	}
+	return flattenTags(filtered)
+}
+
+// updateResourceTags moves the object from the tags_all of the state to the tags argument of the
+// resource merged with the provider default_tags.
+func updateResourceTags(ctx context.Context, d *schema.ResourceData, meta interface{}, objectType string) diag.Diagnostics {
+	oldTagsAll, _ := d.GetChange("tags_all")
+	oldTags := toTagArray(oldTagsAll)
+	if len(oldTags) == 0 {
+		// tags_all is not read back for every object, fall back to the tags of the state.
+		oldTag, _ := d.GetChange("tags")
+		oldTags = toTagArray(oldTag)
+	}
+	newTags := tagsWithDefaults(d, meta)
+	if diags := reconcileTags(ctx, meta.(*apiClient).client, objectType, d.Id(), oldTags, newTags); diags != nil {
+		d.Set("tags_all", oldTagsAll)
+		return diags
+	}
+	d.Set("tags_all", flattenTags(newTags))
+	return nil
+}
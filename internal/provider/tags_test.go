@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	dctapi "github.com/delphix/dct-sdk-go/v25"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testTags(keyValues ...string) []dctapi.Tag {
+	tags := []dctapi.Tag{}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		tags = append(tags, *dctapi.NewTag(keyValues[i], keyValues[i+1]))
+	}
+	return tags
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		name        string
+		defaultTags []dctapi.Tag
+		tags        []dctapi.Tag
+		expected    []dctapi.Tag
+	}{
+		{"no defaults", testTags(), testTags("app", "crm"), testTags("app", "crm")},
+		{"defaults first", testTags("team", "dba"), testTags("app", "crm"), testTags("team", "dba", "app", "crm")},
+		{"tag overrides default", testTags("team", "dba", "env", "dev"), testTags("env", "prod"), testTags("team", "dba", "env", "prod")},
+		{"only defaults", testTags("team", "dba"), testTags(), testTags("team", "dba")},
+	}
+	for _, c := range cases {
+		if merged := mergeDefaultTags(c.defaultTags, c.tags); !reflect.DeepEqual(merged, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, merged)
+		}
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	cases := []struct {
+		name            string
+		oldTags         []dctapi.Tag
+		newTags         []dctapi.Tag
+		expectedAdded   []dctapi.Tag
+		expectedRemoved []dctapi.Tag
+	}{
+		{"unchanged", testTags("app", "crm"), testTags("app", "crm"), testTags(), testTags()},
+		{"added", testTags("app", "crm"), testTags("app", "crm", "team", "dba"), testTags("team", "dba"), testTags()},
+		{"removed", testTags("app", "crm", "team", "dba"), testTags("app", "crm"), testTags(), testTags("team", "dba")},
+		{"value changed", testTags("env", "dev"), testTags("env", "prod"), testTags("env", "prod"), testTags("env", "dev")},
+		{"order ignored", testTags("a", "1", "b", "2"), testTags("b", "2", "a", "1"), testTags(), testTags()},
+	}
+	for _, c := range cases {
+		added, removed := diffTags(c.oldTags, c.newTags)
+		if !reflect.DeepEqual(added, c.expectedAdded) {
+			t.Errorf("%s: expected added %v, got %v", c.name, c.expectedAdded, added)
+		}
+		if !reflect.DeepEqual(removed, c.expectedRemoved) {
+			t.Errorf("%s: expected removed %v, got %v", c.name, c.expectedRemoved, removed)
+		}
+	}
+}
+
+func TestFlattenResourceTags(t *testing.T) {
+	meta := &apiClient{defaultTags: testTags("team", "dba", "env", "dev")}
+	d := schema.TestResourceDataRaw(t, resourceVdbGroup().Schema, map[string]interface{}{
+		"name": "group",
+		"tags": flattenTags(testTags("app", "crm", "env", "dev")),
+	})
+
+	// the default tag team is hidden, env is kept because the resource configures it and the tag
+	// set outside of Terraform is kept so that it shows as a diff.
+	flattened := flattenResourceTags(d, meta, testTags("team", "dba", "env", "dev", "app", "crm", "owner", "ops"))
+	expected := flattenTags(testTags("env", "dev", "app", "crm", "owner", "ops"))
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("expected %v, got %v", expected, flattened)
+	}
+
+	// a tag with the key of a default tag but another value is not a default tag.
+	flattened = flattenResourceTags(d, meta, testTags("team", "ops"))
+	expected = flattenTags(testTags("team", "ops"))
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("expected %v, got %v", expected, flattened)
+	}
+}
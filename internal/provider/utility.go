@@ -2,17 +2,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"math"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	dctapi "github.com/delphix/dct-sdk-go/v25"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var SLEEP_TIME = 10
@@ -185,7 +189,8 @@ func flattenDSourceHooks(hooks []dctapi.Hook, oldList []dctapi.SourceOperation)
 			returnedHook["element_id"] = hook.GetElementId()
 			returnedHook["has_credentials"] = hook.GetHasCredentials()
 			credsEnvVars := []map[string]interface{}{}
-			if len(oldList) != 0 {
+			// credentials are never returned by the API, carry them over from the state.
+			if i < len(oldList) {
 				for _, cred := range oldList[i].GetCredentialsEnvVars() {
 					credsEnvVars = append(credsEnvVars, map[string]interface{}{
 						"base_var_name":                cred.BaseVarName,
@@ -245,39 +250,75 @@ func isJobTerminalFailure(job_status string) bool {
 	return job_status == Failed || job_status == Canceled || job_status == Abandoned
 }
 
-// Poll the /dsources/{dsourceId}/snapshots API till atleast one snapshot is created
-func PollSnapshotStatus(d *schema.ResourceData, ctx context.Context, client *dctapi.APIClient) {
+// PollSnapshotStatus waits up to wait_time minutes for the first snapshot of the dSource, reporting the progress
+// of the link job. When no snapshot appears in time, it fails or warns according to snapshot_wait_failure_action.
+func PollSnapshotStatus(d *schema.ResourceData, ctx context.Context, client *dctapi.APIClient, job_id string) diag.Diagnostics {
 	skip := d.Get("skip_wait_for_snapshot_creation") // default false
-	wait_time := d.Get("wait_time")                  // default 3 mins
-
-	if !skip.(bool) {
-		var snapshotRes *dctapi.ListSnapshotsResponse
-		var api_err error
-		maxAttempts := int(math.Round(float64(wait_time.(int)*60) / float64(STATUS_POLL_SLEEP_TIME)))
-		for attempt := 1; attempt <= maxAttempts; attempt++ {
-			snapshotRes, _, api_err = client.DSourcesAPI.GetDsourceSnapshots(ctx, d.Id()).Execute()
-			if api_err != nil {
-				tflog.Error(ctx, DLPX+ERROR+"Error fetching dSource snapshots: "+api_err.Error())
-				break // Exit the loop on error to avoid unnecessary retries
-			}
-			if len(snapshotRes.GetItems()) > 0 {
-				tflog.Info(ctx, DLPX+INFO+"Snapshots are now available.")
-				break // Snapshots found, exit the loop
-			}
-			tflog.Info(ctx, DLPX+INFO+"Attempt "+strconv.Itoa(attempt)+": Waiting for snapshots to become available...")
+	wait_time := d.Get("wait_time")                  // default 0 mins, the snapshot is checked once
 
-			if attempt < maxAttempts {
-				time.Sleep(time.Duration(STATUS_POLL_SLEEP_TIME) * time.Second) // Wait before retrying
+	if skip.(bool) {
+		return nil
+	}
+
+	var last_err string
+	maxAttempts := int(math.Round(float64(wait_time.(int)*60) / float64(STATUS_POLL_SLEEP_TIME)))
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if job_id != "" {
+			jobRes, _, job_err := client.JobsAPI.GetJobById(ctx, job_id).Execute()
+			if job_err != nil {
+				last_err = "Error fetching job " + job_id + ": " + job_err.Error()
+				tflog.Warn(ctx, DLPX+WARN+last_err)
+			} else {
+				tflog.Info(ctx, DLPX+INFO+"DCT-JobId:"+job_id+" has Status:"+jobRes.GetStatus()+" ("+strconv.Itoa(int(jobRes.GetPercentComplete()))+"% complete)")
+				if isJobTerminalFailure(jobRes.GetStatus()) {
+					return diag.Errorf("[NOT OK] Job %s %s before a snapshot of dSource %s was created. Error: %s", job_id, jobRes.GetStatus(), d.Id(), jobRes.GetErrorDetails())
+				}
 			}
 		}
 
-		// After the loop, check for errors or absence of snapshots
+		snapshotRes, _, api_err := client.DSourcesAPI.GetDsourceSnapshots(ctx, d.Id()).Execute()
 		if api_err != nil {
-			tflog.Error(ctx, DLPX+ERROR+"Failed to fetch dSource snapshots due to an error.")
-		} else if len(snapshotRes.GetItems()) == 0 {
-			tflog.Info(ctx, DLPX+INFO+"Maximum attempts reached. Snapshots are not available.")
+			last_err = "Error fetching dSource snapshots: " + api_err.Error()
+			tflog.Warn(ctx, DLPX+WARN+last_err)
+		} else if len(snapshotRes.GetItems()) > 0 {
+			tflog.Info(ctx, DLPX+INFO+"Snapshots are now available.")
+			return nil
+		} else {
+			last_err = ""
+		}
+		tflog.Info(ctx, DLPX+INFO+"Attempt "+strconv.Itoa(attempt)+": Waiting for snapshots to become available...")
+
+		if attempt < maxAttempts {
+			time.Sleep(time.Duration(STATUS_POLL_SLEEP_TIME) * time.Second) // Wait before retrying
 		}
 	}
+
+	summary := fmt.Sprintf("No snapshot of dSource %s was available after waiting %d minutes.", d.Id(), wait_time.(int))
+	if last_err != "" {
+		summary = summary + " Last error: " + last_err
+	}
+	if d.Get("snapshot_wait_failure_action").(string) == SnapshotWaitWarn {
+		tflog.Warn(ctx, DLPX+WARN+summary)
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   "VDBs cannot be provisioned from this dSource until a snapshot exists. Increase wait_time or take a snapshot with sync_trigger.",
+		}}
+	}
+	return diag.Errorf("[NOT OK] %s Increase wait_time or set snapshot_wait_failure_action to %s.", summary, SnapshotWaitWarn)
+}
+
+// snapshotWaitFailureActionSchema returns the schema of the action taken when no snapshot appears after linking.
+func snapshotWaitFailureActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      SnapshotWaitWarn,
+		ValidateFunc: validation.StringInSlice([]string{SnapshotWaitFail, SnapshotWaitWarn}, false),
+	}
 }
 
 func disableVDB(ctx context.Context, client *dctapi.APIClient, vdbId string) diag.Diagnostics {
@@ -319,6 +360,298 @@ func enableVDB(ctx context.Context, client *dctapi.APIClient, vdbId string) diag
 	return nil
 }
 
+func disableDsource(ctx context.Context, client *dctapi.APIClient, dsourceId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Disable dSource "+dsourceId)
+	disableDsourceParam := dctapi.NewDisableDsourceParameters()
+	apiRes, httpRes, err := client.DSourcesAPI.DisableDsource(ctx, dsourceId).DisableDsourceParameters(*disableDsourceParam).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource disable")
+}
+
+func enableDsource(ctx context.Context, client *dctapi.APIClient, dsourceId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Enable dSource "+dsourceId)
+	enableDsourceParam := dctapi.NewEnableDsourceParameters()
+	apiRes, httpRes, err := client.DSourcesAPI.EnableDsource(ctx, dsourceId).EnableDsourceParameters(*enableDsourceParam).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource enable")
+}
+
+func disableEnvironment(ctx context.Context, client *dctapi.APIClient, envId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Disable environment "+envId)
+	apiRes, httpRes, err := client.EnvironmentsAPI.DisableEnvironment(ctx, envId).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "Environment disable")
+}
+
+func enableEnvironment(ctx context.Context, client *dctapi.APIClient, envId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Enable environment "+envId)
+	apiRes, httpRes, err := client.EnvironmentsAPI.EnableEnvironment(ctx, envId).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "Environment enable")
+}
+
+func detachDsource(ctx context.Context, client *dctapi.APIClient, dsourceId string) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Detach dSource "+dsourceId)
+	apiRes, httpRes, err := client.DSourcesAPI.DetachDsource(ctx, dsourceId).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource detach")
+}
+
+// updateDsourceLifecycle applies changes of enabled, is_detached and attach_source_id to an existing dSource.
+// The dSource is enabled before and disabled after the attachment changes. Attaching to a new source while
+// attached detaches the dSource first, which expresses a source migration as a single plan.
+func updateDsourceLifecycle(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient, attach func(sourceId string) diag.Diagnostics) diag.Diagnostics {
+	dsourceId := d.Id()
+	enabled := d.Get("enabled").(bool)
+
+	if d.HasChange("enabled") && enabled {
+		if diags := enableDsource(ctx, client, dsourceId); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChanges("is_detached", "attach_source_id") {
+		wasDetached, _ := d.GetChange("is_detached")
+		attachSourceId := d.Get("attach_source_id").(string)
+
+		// is_detached is optional and computed, only an explicit true in the configuration detaches.
+		detachRequested := false
+		if raw := d.GetRawConfig().GetAttr("is_detached"); !raw.IsNull() && raw.True() {
+			detachRequested = true
+		}
+
+		if detachRequested {
+			if !wasDetached.(bool) {
+				if diags := detachDsource(ctx, client, dsourceId); diags != nil {
+					return diags
+				}
+			}
+		} else if attachSourceId != "" && (wasDetached.(bool) || attachSourceId != d.Get("source_id").(string)) {
+			if !wasDetached.(bool) {
+				if diags := detachDsource(ctx, client, dsourceId); diags != nil {
+					return diags
+				}
+			}
+			if diags := attach(attachSourceId); diags != nil {
+				return diags
+			}
+		}
+	}
+
+	if d.HasChange("enabled") && !enabled {
+		if diags := disableDsource(ctx, client, dsourceId); diags != nil {
+			return diags
+		}
+	}
+	return nil
+}
+
+// applyDsourceLifecycleOnCreate disables or detaches a newly linked dSource when requested in the configuration.
+func applyDsourceLifecycleOnCreate(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient) diag.Diagnostics {
+	if raw := d.GetRawConfig().GetAttr("is_detached"); !raw.IsNull() && raw.True() {
+		if diags := detachDsource(ctx, client, d.Id()); diags != nil {
+			return diags
+		}
+	}
+	if raw := d.GetRawConfig().GetAttr("enabled"); !raw.IsNull() && raw.False() {
+		if diags := disableDsource(ctx, client, d.Id()); diags != nil {
+			return diags
+		}
+	}
+	return nil
+}
+
+// waitForJob polls the job and returns an error diagnostic if it ends in a terminal failure.
+func waitForJob(ctx context.Context, client *dctapi.APIClient, jobId string, action string) diag.Diagnostics {
+	job_res, job_err := PollJobStatus(jobId, ctx, client)
+	if job_err != "" {
+		tflog.Warn(ctx, DLPX+WARN+action+" Job Polling failed. Error: "+job_err)
+	}
+	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_res)
+	if isJobTerminalFailure(job_res) {
+		tflog.Error(ctx, DLPX+ERROR+"Job "+job_res+" "+jobId+"!")
+		return diag.Errorf("[NOT OK] Job %s %s with error %s", jobId, job_res, job_err)
+	}
+	return nil
+}
+
+// searchPageFunc returns one page of the objects matching a DCT search, and the cursor of the next
+// page, which is empty on the last page.
+type searchPageFunc[T any] func(ctx context.Context, client *dctapi.APIClient, body dctapi.SearchBody, sortBy string, cursor string) ([]T, string, diag.Diagnostics)
+
+// searchRequest is a search request of the DCT SDK, returning a response of type Res.
+type searchRequest[R any, Res any] interface {
+	SearchBody(body dctapi.SearchBody) R
+	Sort(sort string) R
+	Cursor(cursor string) R
+	Execute() (Res, *http.Response, error)
+}
+
+// searchResponse is a page of the objects of type T returned by a DCT search.
+type searchResponse[T any] interface {
+	GetItems() []T
+	GetResponseMetadata() dctapi.PaginatedResponseMetadata
+}
+
+// searchPage returns the page function of the DCT search endpoint whose requests are created by newRequest.
+func searchPage[R searchRequest[R, Res], Res searchResponse[T], T any](newRequest func(ctx context.Context, client *dctapi.APIClient) R) searchPageFunc[T] {
+	return func(ctx context.Context, client *dctapi.APIClient, body dctapi.SearchBody, sortBy string, cursor string) ([]T, string, diag.Diagnostics) {
+		req := newRequest(ctx, client).SearchBody(body)
+		if sortBy != "" {
+			req = req.Sort(sortBy)
+		}
+		if cursor != "" {
+			req = req.Cursor(cursor)
+		}
+		res, httpRes, err := req.Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return nil, "", diags
+		}
+		metadata := res.GetResponseMetadata()
+		return res.GetItems(), metadata.GetNextCursor(), nil
+	}
+}
+
+// paginate returns the objects matching a DCT search, following the pagination cursor. When limit is
+// positive, at most limit objects are returned.
+func paginate[T any](ctx context.Context, client *dctapi.APIClient, page searchPageFunc[T], body dctapi.SearchBody, sortBy string, limit int) ([]T, diag.Diagnostics) {
+	items := []T{}
+	cursor := ""
+	for {
+		pageItems, next, diags := page(ctx, client, body, sortBy, cursor)
+		if diags != nil {
+			return nil, diags
+		}
+		items = append(items, pageItems...)
+		if limit > 0 && len(items) >= limit {
+			return items[:limit], nil
+		}
+		if next == "" {
+			return items, nil
+		}
+		cursor = next
+	}
+}
+
+// filterSearchBody returns the search body of a DCT filter expression.
+func filterSearchBody(filter string) dctapi.SearchBody {
+	searchBody := dctapi.NewSearchBody()
+	searchBody.SetFilterExpression(filter)
+	return *searchBody
+}
+
+// searchVdbs returns every VDB matching the DCT filter expression.
+func searchVdbs(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.VDB, diag.Diagnostics) {
+	return paginate(ctx, client, searchVdbsPage, filterSearchBody(filter), "", 0)
+}
+
+// searchVdbsPage returns one page of the VDBs matching the search body.
+var searchVdbsPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchVdbsRequest {
+	return client.VDBsAPI.SearchVdbs(ctx)
+})
+
+// searchDsources returns every dSource matching the DCT filter expression.
+func searchDsources(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.DSource, diag.Diagnostics) {
+	return paginate(ctx, client, searchDsourcesPage, filterSearchBody(filter), "", 0)
+}
+
+// searchDsourcesPage returns one page of the dSources matching the search body.
+var searchDsourcesPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchDsourcesRequest {
+	return client.DSourcesAPI.SearchDsources(ctx)
+})
+
+// searchSources returns every source matching the DCT filter expression.
+func searchSources(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.Source, diag.Diagnostics) {
+	return paginate(ctx, client, searchSourcesPage, filterSearchBody(filter), "", 0)
+}
+
+// searchSourcesPage returns one page of the sources matching the search body.
+var searchSourcesPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchSourcesRequest {
+	return client.SourcesAPI.SearchSources(ctx)
+})
+
+// syncDsource takes a new snapshot of the dSource and waits for the snapshot job to complete.
+func syncDsource(ctx context.Context, client *dctapi.APIClient, dsourceId string, params *dctapi.DSourceSnapshotParameters) diag.Diagnostics {
+	tflog.Info(ctx, DLPX+INFO+"Snapshot dSource "+dsourceId)
+	apiRes, httpRes, err := client.DSourcesAPI.SnapshotDsource(ctx, dsourceId).DSourceSnapshotParameters(*params).Execute()
+	if diags := apiErrorResponseHelper(ctx, apiRes, httpRes, err); diags != nil {
+		return diags
+	}
+	return waitForJob(ctx, client, apiRes.Job.GetId(), "dSource snapshot")
+}
+
+// getLatestDsourceSnapshot returns the most recent snapshot of the dSource, or nil if it has none.
+func getLatestDsourceSnapshot(ctx context.Context, client *dctapi.APIClient, dsourceId string) (*dctapi.Snapshot, diag.Diagnostics) {
+	return searchLatestSnapshot(ctx, client, "dataset_id eq "+filterLiteral(dsourceId))
+}
+
+// searchLatestSnapshot returns the snapshot with the latest timestamp matching the filter, or nil when none match.
+func searchLatestSnapshot(ctx context.Context, client *dctapi.APIClient, filter string) (*dctapi.Snapshot, diag.Diagnostics) {
+	searchBody := dctapi.NewSearchBody()
+	searchBody.SetFilterExpression(filter)
+	res, httpRes, err := client.SnapshotsAPI.SearchSnapshots(ctx).Limit(1).Sort("-timestamp").SearchBody(*searchBody).Execute()
+	if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+		return nil, diags
+	}
+	if len(res.GetItems()) == 0 {
+		return nil, nil
+	}
+	return &res.GetItems()[0], nil
+}
+
+// setLatestDsourceSnapshot stores the ID and timestamp of the most recent dSource snapshot in the state.
+func setLatestDsourceSnapshot(ctx context.Context, d *schema.ResourceData, client *dctapi.APIClient) diag.Diagnostics {
+	snapshot, diags := getLatestDsourceSnapshot(ctx, client, d.Id())
+	if diags != nil {
+		return diags
+	}
+	if snapshot == nil {
+		tflog.Info(ctx, DLPX+INFO+"No snapshot found for dSource "+d.Id())
+		return nil
+	}
+	d.Set("last_sync_snapshot_id", snapshot.GetId())
+	d.Set("last_sync_snapshot_timestamp", snapshot.GetTimestamp().Format(time.RFC3339Nano))
+	d.Set("last_sync_snapshot_timeflow_id", snapshot.GetTimeflowId())
+	return nil
+}
+
+// hasDsourceParameterChanges reports whether any of the changed keys must be sent to the dSource update API.
+func hasDsourceParameterChanges(changedKeys []string) bool {
+	for _, key := range changedKeys {
+		if !dsourceLocalKeys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// customizeDiffDsourceSync marks the last sync snapshot attributes as unknown when a sync is triggered,
+// so that dependent resources use the snapshot taken during the apply.
+func customizeDiffDsourceSync(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("sync_trigger") {
+		if err := d.SetNewComputed("last_sync_snapshot_id"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("last_sync_snapshot_timestamp"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("last_sync_snapshot_timeflow_id"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func revertChanges(d *schema.ResourceData, changedKeys []string) {
 	for _, key := range changedKeys {
 		old, _ := d.GetChange(key)
@@ -362,14 +695,627 @@ func toIntArray(array interface{}) []int32 {
 	return items
 }
 
-func isSnapSyncFailure(job_id string, ctx context.Context, client *dctapi.APIClient) bool {
-	res, httpRes, _ := client.JobsAPI.GetJobById(ctx, job_id).Execute()
-	if httpRes.StatusCode == 200 && len(res.GetTasks()) != 0 {
-		tflog.Info(ctx, "Status of task 1 is "+res.GetTasks()[0].GetStatus())
-		if res.GetTasks()[0].GetStatus() == "COMPLETED" {
-			tflog.Info(ctx, "rolling back Dsource")
+// linkJobFailure describes the task of a dSource link job that failed.
+type linkJobFailure struct {
+	phase   string
+	task    string
+	message string
+}
+
+// analyzeLinkJobFailure finds the first task of the link job that did not complete and classifies
+// the phase it belongs to. The phase is LinkPhaseUnknown when the job or its tasks cannot be read.
+func analyzeLinkJobFailure(ctx context.Context, client *dctapi.APIClient, job_id string) linkJobFailure {
+	failure := linkJobFailure{phase: LinkPhaseUnknown}
+	res, httpRes, err := client.JobsAPI.GetJobById(ctx, job_id).Execute()
+	if err != nil || res == nil {
+		if httpRes == nil {
+			failure.message = "Received nil response for Job ID " + job_id
+		} else if err != nil {
+			failure.message = err.Error()
+		}
+		tflog.Error(ctx, DLPX+ERROR+"Unable to read job "+job_id+" to classify the failure. "+failure.message)
+		return failure
+	}
+	failure.message = res.GetErrorDetails()
+
+	for _, task := range res.GetTasks() {
+		if task.GetStatus() == Completed {
+			continue
+		}
+		failure.task = task.GetTitle()
+		failure.phase = linkTaskPhase(task.GetTitle())
+		for _, event := range task.GetEvents() {
+			if event.GetMessageDetails() != "" {
+				failure.message = event.GetMessageDetails()
+			}
+		}
+		break
+	}
+	tflog.Info(ctx, DLPX+INFO+"Job "+job_id+" failed in phase "+failure.phase+", task: "+failure.task)
+	return failure
+}
+
+// linkTaskPhase maps the title of a link job task to the phase it belongs to.
+func linkTaskPhase(title string) string {
+	title = strings.ToLower(title)
+	switch {
+	case strings.Contains(title, "logsync") || strings.Contains(title, "log sync"):
+		return LinkPhaseLogSync
+	case strings.Contains(title, "snapsync") || strings.Contains(title, "snapshot") || strings.Contains(title, "sync"):
+		return LinkPhaseSnapshot
+	default:
+		return LinkPhaseLink
+	}
+}
+
+// shouldRollbackLinkFailure reports whether the failed phase is one of rollback_on_failure_phases.
+func shouldRollbackLinkFailure(d *schema.ResourceData, failure linkJobFailure) bool {
+	phases := toStringArray(d.Get("rollback_on_failure_phases"))
+	if len(phases) == 0 {
+		phases = defaultRollbackPhases
+	}
+	for _, phase := range phases {
+		if phase == failure.phase {
 			return true
 		}
 	}
 	return false
 }
+
+// linkJobFailureDiags returns the diagnostics of a failed link job, naming the failing phase and task.
+func linkJobFailureDiags(job_id string, job_status string, failure linkJobFailure) diag.Diagnostics {
+	return diag.Errorf("[NOT OK] Job %s %s in the %s phase. Task: %s / Error: %s", job_id, job_status, failure.phase, failure.task, failure.message)
+}
+
+// linkDsourceAndWait waits for the link job of the dSource. A failed link is rolled back according to
+// rollback_on_failure, otherwise the dSource is read so that it is kept in the state. After a successful
+// link it waits for the first snapshot when waitForSnapshot is set and records the latest snapshot.
+// The returned diagnostics hold the snapshot wait warnings, or the errors that must stop the creation.
+func linkDsourceAndWait(ctx context.Context, d *schema.ResourceData, meta interface{}, job *dctapi.Job, read schema.ReadContextFunc, waitForSnapshot bool) diag.Diagnostics {
+	client := meta.(*apiClient).client
+
+	job_res, job_err := PollJobStatus(job.GetId(), ctx, client)
+	if job_err != "" {
+		tflog.Error(ctx, DLPX+ERROR+"Job Polling failed but continuing with dSource creation. Error: "+job_err)
+	}
+
+	tflog.Info(ctx, DLPX+INFO+"Job result is "+job_res)
+
+	rollback_on_failure := d.Get("rollback_on_failure").(bool)
+
+	if isJobTerminalFailure(job_res) {
+		tflog.Error(ctx, DLPX+ERROR+"Job "+job_res+" "+job.GetId()+"!")
+		failure := analyzeLinkJobFailure(ctx, client, job.GetId())
+		if failure.message == "" {
+			failure.message = job_err
+		}
+		if rollback_on_failure && job_res == Failed && shouldRollbackLinkFailure(d, failure) {
+			tflog.Info(ctx, DLPX+INFO+"Rolling back dSource "+d.Id()+" after a failure in the "+failure.phase+" phase.")
+			deleteDiags := deleteDsource(ctx, client, d.Id(), false)
+			if deleteDiags.HasError() {
+				return append(linkJobFailureDiags(job.GetId(), job_res, failure), deleteDiags...)
+			}
+			d.SetId("")
+		} else {
+			readDiags := read(ctx, d, meta)
+
+			if readDiags.HasError() {
+				return readDiags
+			}
+		}
+		return linkJobFailureDiags(job.GetId(), job_res, failure)
+	}
+
+	var snapshotDiags diag.Diagnostics
+	if waitForSnapshot {
+		snapshotDiags = PollSnapshotStatus(d, ctx, client, job.GetId())
+		if snapshotDiags.HasError() {
+			return snapshotDiags
+		}
+	}
+
+	if diags := setLatestDsourceSnapshot(ctx, d, client); diags != nil {
+		tflog.Warn(ctx, DLPX+WARN+"Unable to read the latest snapshot of dSource "+d.Id())
+	}
+	return snapshotDiags
+}
+
+// rollbackOnFailurePhasesSchema returns the schema of the link phases whose failure removes the dSource.
+func rollbackOnFailurePhasesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{LinkPhaseLink, LinkPhaseSnapshot, LinkPhaseLogSync}, false),
+		},
+		// only used while linking, changes after creation are suppressed.
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Id() != ""
+		},
+	}
+}
+
+// credentialSourceKeys groups the schema keys of each mutually exclusive secret source.
+// Keys are relative to a prefix such as "ase_db_", "non_sys_" or "fallback_".
+var credentialSourceKeys = []struct {
+	source string
+	keys   []string
+}{
+	{"password", []string{"password"}},
+	{"hashicorp", []string{"hashicorp_vault_engine", "hashicorp_vault_secret_path", "hashicorp_vault_username_key", "hashicorp_vault_secret_key"}},
+	{"azure", []string{"azure_vault_name", "azure_vault_username_key", "azure_vault_secret_key"}},
+	{"cyberark", []string{"cyberark_vault_query_string"}},
+}
+
+// conflictingCredentialKeys returns the prefixed keys of every secret source other than the given one,
+// so that a credential can only be read from a single source.
+func conflictingCredentialKeys(prefix string, source string) []string {
+	keys := []string{}
+	for _, group := range credentialSourceKeys {
+		if group.source == source {
+			continue
+		}
+		for _, key := range group.keys {
+			keys = append(keys, prefix+key)
+		}
+	}
+	return keys
+}
+
+// dsourceOperationsSchema returns the schema of a dSource hook list such as ops_pre_sync or ops_post_sync.
+func dsourceOperationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"command": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"shell": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"element_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"has_credentials": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"credentials_env_vars": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"base_var_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"password": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"vault": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"hashicorp_vault_engine": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"hashicorp_vault_secret_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"hashicorp_vault_username_key": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"hashicorp_vault_secret_key": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"azure_vault_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"azure_vault_username_key": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"azure_vault_secret_key": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"cyberark_vault_query_string": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dsourceTagsSchema returns the schema of the tags of a dSource.
+func dsourceTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// createOnlySchema returns an optional schema for a link input that DCT doesn't return on read.
+// Changing it after creation is only saved to the state.
+func createOnlySchema(valueType schema.ValueType) *schema.Schema {
+	return &schema.Schema{
+		Type:             valueType,
+		Optional:         true,
+		DiffSuppressFunc: suppressCreateOnlyDiff,
+	}
+}
+
+// suppressCreateOnlyDiff suppresses the diff of a create-only input of an existing resource whose state
+// has no value for it, as after an import or with a state written by an earlier provider version.
+func suppressCreateOnlyDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	if name := strings.SplitN(k, ".", 2)[0]; name != k {
+		oldValue, _ := d.GetChange(name)
+		return isEmpty(oldValue)
+	}
+	return old == ""
+}
+
+// customizeDiffDsourceUpgrade validates at plan time that a changed upgrade_repository_id
+// is a repository of the environment returned by environmentId.
+func customizeDiffDsourceUpgrade(environmentId func(ctx context.Context, d *schema.ResourceDiff, client *dctapi.APIClient) (string, error)) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange("upgrade_repository_id") || !d.NewValueKnown("upgrade_repository_id") {
+			return nil
+		}
+		repositoryId := d.Get("upgrade_repository_id").(string)
+		if repositoryId == "" {
+			return nil
+		}
+		client := meta.(*apiClient).client
+		envId, err := environmentId(ctx, d, client)
+		if err != nil {
+			return err
+		}
+		env, _, err := client.EnvironmentsAPI.GetEnvironmentById(ctx, envId).Execute()
+		if err != nil {
+			return fmt.Errorf("unable to read environment %s to validate upgrade_repository_id: %s", envId, err.Error())
+		}
+		if findEnvironmentRepository(env.GetRepositories(), repositoryId) == nil {
+			return fmt.Errorf("repository %s does not exist on environment %s", repositoryId, envId)
+		}
+		return nil
+	}
+}
+
+// searchSnapshots returns every snapshot matching the DCT filter expression.
+func searchSnapshots(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.Snapshot, diag.Diagnostics) {
+	return paginate(ctx, client, searchSnapshotsPage, filterSearchBody(filter), "", 0)
+}
+
+// searchSnapshotsPage returns one page of the snapshots matching the search body.
+var searchSnapshotsPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchSnapshotsRequest {
+	return client.SnapshotsAPI.SearchSnapshots(ctx)
+})
+
+// getDsourceDependents returns the VDBs provisioned from the dSource and the dSource snapshots.
+// Snapshots are only returned when the dSource has dependent VDBs.
+func getDsourceDependents(ctx context.Context, client *dctapi.APIClient, dsourceId string) ([]dctapi.VDB, []dctapi.Snapshot, diag.Diagnostics) {
+	vdbs, diags := searchVdbs(ctx, client, "parent_dsource_id eq "+filterLiteral(dsourceId))
+	if diags != nil {
+		return nil, nil, diags
+	}
+	if len(vdbs) == 0 {
+		return vdbs, nil, nil
+	}
+	snapshots, diags := searchSnapshots(ctx, client, "dataset_id eq "+filterLiteral(dsourceId))
+	if diags != nil {
+		return nil, nil, diags
+	}
+	return vdbs, snapshots, nil
+}
+
+// describeDsourceDependents returns a readable list of the names and IDs of the dSource dependents.
+func describeDsourceDependents(vdbs []dctapi.VDB, snapshots []dctapi.Snapshot) string {
+	vdbNames := make([]string, 0, len(vdbs))
+	for _, vdb := range vdbs {
+		vdbNames = append(vdbNames, vdb.GetName()+" ("+vdb.GetId()+")")
+	}
+	snapshotIds := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		snapshotIds = append(snapshotIds, snapshot.GetId())
+	}
+	return fmt.Sprintf("Dependent VDBs: [%s]. Snapshots: [%s].", strings.Join(vdbNames, ", "), strings.Join(snapshotIds, ", "))
+}
+
+// deleteVdbWithChildren deletes the VDBs provisioned from the VDB, then the VDB itself.
+func deleteVdbWithChildren(ctx context.Context, client *dctapi.APIClient, vdbId string) diag.Diagnostics {
+	children, diags := searchVdbs(ctx, client, "parent_id eq "+filterLiteral(vdbId))
+	if diags != nil {
+		return diags
+	}
+	for _, child := range children {
+		if diags := deleteVdbWithChildren(ctx, client, child.GetId()); diags != nil {
+			return diags
+		}
+	}
+
+	tflog.Info(ctx, DLPX+INFO+"Deleting dependent VDB "+vdbId)
+	deleteVdbParams := dctapi.NewDeleteVDBParametersWithDefaults()
+	deleteVdbParams.SetForce(true)
+	res, httpRes, err := client.VDBsAPI.DeleteVdb(ctx, vdbId).DeleteVDBParameters(*deleteVdbParams).Execute()
+	if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+		return diags
+	}
+	if diags := waitForJob(ctx, client, res.Job.GetId(), "VDB delete"); diags != nil {
+		return diags
+	}
+	_, diags = PollForObjectDeletion(ctx, func() (interface{}, *http.Response, error) {
+		return client.VDBsAPI.GetVdbById(ctx, vdbId).Execute()
+	})
+	return diags
+}
+
+// jsonSemanticDiffSuppress suppresses diffs between two JSON documents that only differ in
+// formatting or key order.
+func jsonSemanticDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// flattenJsonParameters returns the JSON form of parameters read from the API. The current value is
+// kept when it is semantically equal, so that the formatting chosen in the configuration is preserved.
+func flattenJsonParameters(parameters map[string]interface{}, current string) (string, error) {
+	raw, err := json.Marshal(parameters)
+	if err != nil {
+		return "", err
+	}
+	if jsonSemanticDiffSuppress("", current, string(raw), nil) {
+		return current, nil
+	}
+	return string(raw), nil
+}
+
+// searchTimeflows returns every timeflow matching the DCT filter expression.
+func searchTimeflows(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.Timeflow, diag.Diagnostics) {
+	return paginate(ctx, client, searchTimeflowsPage, filterSearchBody(filter), "", 0)
+}
+
+// searchTimeflowsPage returns one page of the timeflows matching the search body.
+var searchTimeflowsPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchTimeflowsRequest {
+	return client.TimeflowsAPI.SearchTimeflows(ctx)
+})
+
+// datasetTimeflowRange is a time range of a timeflow, with the timeflow it belongs to.
+type datasetTimeflowRange struct {
+	timeflowId string
+	dctapi.TimeflowRange
+}
+
+// getDatasetTimeflowRanges returns the time ranges of the given timeflow, or of every timeflow of the
+// dataset when timeflowId is empty.
+func getDatasetTimeflowRanges(ctx context.Context, client *dctapi.APIClient, datasetId string, timeflowId string) ([]datasetTimeflowRange, diag.Diagnostics) {
+	timeflowIds := []string{}
+	if timeflowId != "" {
+		timeflowIds = append(timeflowIds, timeflowId)
+	} else {
+		timeflows, diags := searchTimeflows(ctx, client, "dataset_id eq "+filterLiteral(datasetId))
+		if diags != nil {
+			return nil, diags
+		}
+		for _, timeflow := range timeflows {
+			timeflowIds = append(timeflowIds, timeflow.GetId())
+		}
+	}
+
+	ranges := []datasetTimeflowRange{}
+	for _, id := range timeflowIds {
+		res, httpRes, err := client.TimeflowsAPI.GetTimeflowRange(ctx, id).TimeflowRangeParameters(*dctapi.NewTimeflowRangeParameters()).Execute()
+		if diags := apiErrorResponseHelper(ctx, res, httpRes, err); diags != nil {
+			return nil, diags
+		}
+		for _, item := range res.GetItems() {
+			ranges = append(ranges, datasetTimeflowRange{timeflowId: id, TimeflowRange: item})
+		}
+	}
+	return ranges, nil
+}
+
+// customizeDiffVdbTimestamp rejects, at plan time, a timestamp provision whose timestamp is outside
+// every provisionable range of the source dataset.
+func customizeDiffVdbTimestamp(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || d.Get("provision_type").(string) != "timestamp" {
+		return nil
+	}
+	if !d.NewValueKnown("timestamp") || !d.NewValueKnown("source_data_id") {
+		return nil
+	}
+	timestamp, datasetId := d.Get("timestamp").(string), d.Get("source_data_id").(string)
+	if timestamp == "" || datasetId == "" {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, timestamp); err != nil {
+		return fmt.Errorf("The timestamp parameter %s is not valid RFC3339 format. Please provide valid value. Example: 2021-05-01T08:51:34.148000+00:00", timestamp)
+	}
+
+	client := meta.(*apiClient).client
+	ranges, diags := getDatasetTimeflowRanges(ctx, client, datasetId, "")
+	if diags.HasError() {
+		// the provision call reports the error if the ranges can't be read, don't block the plan.
+		tflog.Warn(ctx, DLPX+WARN+"Unable to read the timeflow ranges of "+datasetId+", skipping the timestamp validation.")
+		return nil
+	}
+	return validateTimestampInRanges(timestamp, datasetId, ranges)
+}
+
+// validateTimestampInRanges returns an error listing the provisionable ranges when the RFC 3339
+// timestamp is outside all of them.
+func validateTimestampInRanges(timestamp string, datasetId string, ranges []datasetTimeflowRange) error {
+	tt, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return fmt.Errorf("The timestamp parameter %s is not valid RFC3339 format. Please provide valid value. Example: 2021-05-01T08:51:34.148000+00:00", timestamp)
+	}
+
+	provisionable := []string{}
+	for _, r := range ranges {
+		if !r.GetProvisionable() {
+			continue
+		}
+		if !tt.Before(r.GetStartTimestamp()) && !tt.After(r.GetEndTimestamp()) {
+			return nil
+		}
+		provisionable = append(provisionable, r.GetStartTimestamp().Format(time.RFC3339Nano)+" - "+r.GetEndTimestamp().Format(time.RFC3339Nano))
+	}
+	return fmt.Errorf("timestamp %s is outside every provisionable range of %s: [%s]", timestamp, datasetId, strings.Join(provisionable, ", "))
+}
+
+// searchEngines returns every engine matching the DCT filter expression.
+func searchEngines(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.RegisteredEngine, diag.Diagnostics) {
+	return paginate(ctx, client, searchEnginesPage, filterSearchBody(filter), "", 0)
+}
+
+// searchEnginesPage returns one page of the engines matching the search body.
+var searchEnginesPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchEnginesRequest {
+	return client.ManagementAPI.SearchEngines(ctx)
+})
+
+// lookupFilterSchema returns the arguments shared by the data sources looking objects up by name,
+// engine, tags or a DCT filter expression.
+func lookupFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"engine_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"filter_tags": filterTagsSchema(),
+		"filter": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+// lookupFilterExpression builds the DCT filter expression from the lookupFilterSchema arguments.
+func lookupFilterExpression(d *schema.ResourceData) string {
+	conditions := []string{}
+	if v, has_v := d.GetOk("name"); has_v {
+		conditions = append(conditions, "name eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("engine_id"); has_v {
+		conditions = append(conditions, "engine_id eq "+filterLiteral(v.(string)))
+	}
+	if v, has_v := d.GetOk("filter_tags"); has_v {
+		conditions = append(conditions, tagsFilterConditions(toTagArray(v))...)
+	}
+	if v, has_v := d.GetOk("filter"); has_v {
+		conditions = append(conditions, "("+v.(string)+")")
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func filterTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// filterLiteral quotes a value for a DCT filter expression, escaping backslashes and single quotes
+// so that the value can't end the literal.
+func filterLiteral(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// tagsFilterConditions returns the DCT filter conditions matching objects that have all the tags.
+func tagsFilterConditions(tags []dctapi.Tag) []string {
+	conditions := []string{}
+	for _, tag := range tags {
+		conditions = append(conditions, "tags CONTAINS {key eq "+filterLiteral(tag.GetKey())+" AND value eq "+filterLiteral(tag.GetValue())+"}")
+	}
+	return conditions
+}
+
+func computedTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// searchEnvironments returns every environment matching the DCT filter expression.
+func searchEnvironments(ctx context.Context, client *dctapi.APIClient, filter string) ([]dctapi.Environment, diag.Diagnostics) {
+	return paginate(ctx, client, searchEnvironmentsPage, filterSearchBody(filter), "", 0)
+}
+
+// searchEnvironmentsPage returns one page of the environments matching the search body.
+var searchEnvironmentsPage = searchPage(func(ctx context.Context, client *dctapi.APIClient) dctapi.ApiSearchEnvironmentsRequest {
+	return client.EnvironmentsAPI.SearchEnvironments(ctx)
+})